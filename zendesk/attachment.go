@@ -1,7 +1,7 @@
 package zendesk
 
 import (
-	"fmt"
+	"context"
 	"io"
 
 	"github.com/google/go-querystring/query"
@@ -30,8 +30,13 @@ type Upload struct {
 //
 // Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/core/attachments#getting-attachments
 func (c *client) ShowAttachment(id int64) (*Attachment, error) {
+	return c.ShowAttachmentContext(context.Background(), id)
+}
+
+// ShowAttachmentContext is like ShowAttachment but uses ctx for the underlying request.
+func (c *client) ShowAttachmentContext(ctx context.Context, id int64) (*Attachment, error) {
 	out := new(APIPayload)
-	err := c.get(fmt.Sprintf("/api/v2/tickets/%d.json", id), out)
+	err := c.get(ctx, "ShowAttachment", routef("/api/v2/tickets/%d.json", id), out)
 	return out.Attachment, err
 }
 
@@ -39,6 +44,11 @@ func (c *client) ShowAttachment(id int64) (*Attachment, error) {
 //
 // Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/core/attachments#uploading-files
 func (c *client) UploadFile(filename string, token *string, filecontent io.Reader) (*Upload, error) {
+	return c.UploadFileContext(context.Background(), filename, token, filecontent)
+}
+
+// UploadFileContext is like UploadFile but uses ctx for the underlying request.
+func (c *client) UploadFileContext(ctx context.Context, filename string, token *string, filecontent io.Reader) (*Upload, error) {
 	params, err := query.Values(struct {
 		Filename string  `url:"filename"`
 		Token    *string `url:"token,omitempty"`
@@ -51,11 +61,13 @@ func (c *client) UploadFile(filename string, token *string, filecontent io.Reade
 		"Content-Type": "application/binary",
 	}
 
-	res, err := c.request("POST", fmt.Sprintf("/api/v2/uploads.json?%s", params.Encode()), headers, filecontent)
+	res, err := c.send(ctx, "UploadFile", "POST", routef("/api/v2/uploads.json?%s", params.Encode()), headers, filecontent)
 	if err != nil {
 		return nil, err
 	}
 
+	defer res.Body.Close()
+
 	out := new(APIPayload)
 	err = unmarshall(res, out)
 	return out.Upload, err
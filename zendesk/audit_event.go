@@ -0,0 +1,243 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// TicketAuditEvent is an event of a ticket audit. The events are decoded into
+// the struct matching their type, such as *CommentEvent or *ChangeEvent, and
+// into a *RawAuditEvent for the other types.
+//
+// Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/support/ticket_audits#audit-events
+type TicketAuditEvent interface {
+	// EventType returns the type of the event, e.g. Comment or Change.
+	EventType() string
+}
+
+// CommentEvent represents a comment added to a ticket.
+type CommentEvent struct {
+	ID          *int64       `json:"id,omitempty"`
+	AuthorID    *int64       `json:"author_id,omitempty"`
+	Body        *string      `json:"body,omitempty"`
+	HTMLBody    *string      `json:"html_body,omitempty"`
+	PlainBody   *string      `json:"plain_body,omitempty"`
+	Public      *bool        `json:"public,omitempty"`
+	Attachments []Attachment `json:"attachments,omitempty"`
+	AuditID     *int64       `json:"audit_id,omitempty"`
+	Via         *Via         `json:"via,omitempty"`
+}
+
+// EventType implements TicketAuditEvent.
+func (e *CommentEvent) EventType() string { return "Comment" }
+
+// VoiceCommentEvent represents a phone call recorded on a ticket.
+type VoiceCommentEvent struct {
+	ID                   *int64            `json:"id,omitempty"`
+	AuthorID             *int64            `json:"author_id,omitempty"`
+	Body                 *string           `json:"body,omitempty"`
+	HTMLBody             *string           `json:"html_body,omitempty"`
+	Public               *bool             `json:"public,omitempty"`
+	FormattedFrom        *string           `json:"formatted_from,omitempty"`
+	FormattedTo          *string           `json:"formatted_to,omitempty"`
+	TranscriptionVisible *bool             `json:"transcription_visible,omitempty"`
+	Attachments          []Attachment      `json:"attachments,omitempty"`
+	Data                 *VoiceCommentData `json:"data,omitempty"`
+	Via                  *Via              `json:"via,omitempty"`
+}
+
+// EventType implements TicketAuditEvent.
+func (e *VoiceCommentEvent) EventType() string { return "VoiceComment" }
+
+// VoiceCommentData represents the details of the call of a voice comment.
+type VoiceCommentData struct {
+	From              *string    `json:"from,omitempty"`
+	To                *string    `json:"to,omitempty"`
+	RecordingURL      *string    `json:"recording_url,omitempty"`
+	StartedAt         *time.Time `json:"started_at,omitempty"`
+	CallDuration      *int64     `json:"call_duration,omitempty"`
+	AnsweredByID      *int64     `json:"answered_by_id,omitempty"`
+	TranscriptionText *string    `json:"transcription_text,omitempty"`
+	Location          *string    `json:"location,omitempty"`
+}
+
+// CreateEvent represents the value of a field when a ticket is created.
+type CreateEvent struct {
+	ID        *int64          `json:"id,omitempty"`
+	FieldName *string         `json:"field_name,omitempty"`
+	Value     json.RawMessage `json:"value,omitempty"`
+	Via       *Via            `json:"via,omitempty"`
+
+	// Set for the tags by the child events of the incremental ticket event export.
+	AddedTags []string `json:"added_tags,omitempty"`
+}
+
+// EventType implements TicketAuditEvent.
+func (e *CreateEvent) EventType() string { return "Create" }
+
+// ChangeEvent represents the change of a field of a ticket.
+type ChangeEvent struct {
+	ID            *int64          `json:"id,omitempty"`
+	FieldName     *string         `json:"field_name,omitempty"`
+	Value         json.RawMessage `json:"value,omitempty"`
+	PreviousValue json.RawMessage `json:"previous_value,omitempty"`
+	Via           *Via            `json:"via,omitempty"`
+
+	// Set for the tags by the child events of the incremental ticket event export.
+	AddedTags   []string `json:"added_tags,omitempty"`
+	RemovedTags []string `json:"removed_tags,omitempty"`
+}
+
+// EventType implements TicketAuditEvent.
+func (e *ChangeEvent) EventType() string { return "Change" }
+
+// NotificationEvent represents an email notification sent by a trigger or an automation.
+type NotificationEvent struct {
+	ID         *int64  `json:"id,omitempty"`
+	Subject    *string `json:"subject,omitempty"`
+	Body       *string `json:"body,omitempty"`
+	Recipients []int64 `json:"recipients,omitempty"`
+	Via        *Via    `json:"via,omitempty"`
+}
+
+// EventType implements TicketAuditEvent.
+func (e *NotificationEvent) EventType() string { return "Notification" }
+
+// SatisfactionRatingEvent represents the satisfaction rating given to a ticket.
+type SatisfactionRatingEvent struct {
+	ID         *int64  `json:"id,omitempty"`
+	Score      *string `json:"score,omitempty"`
+	AssigneeID *int64  `json:"assignee_id,omitempty"`
+	Body       *string `json:"body,omitempty"`
+	Via        *Via    `json:"via,omitempty"`
+}
+
+// EventType implements TicketAuditEvent.
+func (e *SatisfactionRatingEvent) EventType() string { return "SatisfactionRating" }
+
+// RawAuditEvent holds an event of a type without a dedicated struct.
+type RawAuditEvent struct {
+	Type string
+	// Raw holds the undecoded event.
+	Raw json.RawMessage
+}
+
+// EventType implements TicketAuditEvent.
+func (e *RawAuditEvent) EventType() string { return e.Type }
+
+// MarshalJSON encodes the undecoded event.
+func (e *RawAuditEvent) MarshalJSON() ([]byte, error) {
+	if e.Raw == nil {
+		return json.Marshal(map[string]string{"type": e.Type})
+	}
+	return e.Raw, nil
+}
+
+// TicketAuditEvents holds the events of a ticket audit.
+type TicketAuditEvents []TicketAuditEvent
+
+// UnmarshalJSON decodes each event into the struct matching its type.
+func (events *TicketAuditEvents) UnmarshalJSON(data []byte) error {
+	var raws []json.RawMessage
+	if err := json.Unmarshal(data, &raws); err != nil {
+		return err
+	}
+
+	if raws == nil {
+		*events = nil
+		return nil
+	}
+
+	decoded := make(TicketAuditEvents, len(raws))
+	for i, raw := range raws {
+		event, err := unmarshalAuditEvent(raw)
+		if err != nil {
+			return err
+		}
+		decoded[i] = event
+	}
+
+	*events = decoded
+	return nil
+}
+
+// MarshalJSON encodes the events along with their type.
+func (events TicketAuditEvents) MarshalJSON() ([]byte, error) {
+	if events == nil {
+		return []byte("null"), nil
+	}
+
+	raws := make([]json.RawMessage, len(events))
+	for i, event := range events {
+		if event == nil {
+			raws[i] = json.RawMessage("null")
+			continue
+		}
+
+		raw, err := marshalAuditEvent(event)
+		if err != nil {
+			return nil, err
+		}
+		raws[i] = raw
+	}
+	return json.Marshal(raws)
+}
+
+func unmarshalAuditEvent(raw json.RawMessage) (TicketAuditEvent, error) {
+	var head struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(raw, &head); err != nil {
+		return nil, err
+	}
+
+	var event TicketAuditEvent
+	switch head.Type {
+	case "Comment":
+		event = new(CommentEvent)
+	case "VoiceComment":
+		event = new(VoiceCommentEvent)
+	case "Create":
+		event = new(CreateEvent)
+	case "Change":
+		event = new(ChangeEvent)
+	case "Notification":
+		event = new(NotificationEvent)
+	case "SatisfactionRating":
+		event = new(SatisfactionRatingEvent)
+	default:
+		return &RawAuditEvent{Type: head.Type, Raw: append(json.RawMessage(nil), raw...)}, nil
+	}
+
+	if err := json.Unmarshal(raw, event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// marshalAuditEvent encodes event and adds its type, which the event structs
+// don't hold.
+func marshalAuditEvent(event TicketAuditEvent) (json.RawMessage, error) {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := event.(*RawAuditEvent); ok {
+		return data, nil
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	if fields == nil {
+		fields = make(map[string]json.RawMessage)
+	}
+
+	fields["type"], err = json.Marshal(event.EventType())
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(fields)
+}
@@ -0,0 +1,82 @@
+package zendesk_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/MEDIGO/go-zendesk/zendesk"
+	"github.com/stretchr/testify/require"
+)
+
+const auditJSON = `{
+	"id": 1,
+	"ticket_id": 2,
+	"events": [
+		{"id": 10, "type": "Comment", "author_id": 5, "body": "Hello", "html_body": "<p>Hello</p>", "public": true, "attachments": [{"id": 7, "file_name": "a.txt"}]},
+		{"id": 11, "type": "Create", "field_name": "tags", "value": ["a", "b"]},
+		{"id": 12, "type": "Change", "field_name": "status", "value": "solved", "previous_value": "open"},
+		{"id": 13, "type": "Notification", "subject": "Updated", "body": "Your request was updated", "recipients": [5, 6]},
+		{"id": 14, "type": "VoiceComment", "public": false, "formatted_from": "+1 555", "data": {"from": "+1555", "call_duration": 42}},
+		{"id": 15, "type": "SatisfactionRating", "score": "good", "assignee_id": 8, "body": "Thanks"},
+		{"id": 16, "type": "Cc", "recipients": [9]}
+	]
+}`
+
+func TestTicketAuditEventsUnmarshal(t *testing.T) {
+	var audit zendesk.TicketAudit
+	require.NoError(t, json.Unmarshal([]byte(auditJSON), &audit))
+	require.Len(t, audit.Events, 7)
+
+	comment, ok := audit.Events[0].(*zendesk.CommentEvent)
+	require.True(t, ok)
+	require.Equal(t, "Hello", *comment.Body)
+	require.True(t, *comment.Public)
+	require.Equal(t, "a.txt", *comment.Attachments[0].FileName)
+
+	create := audit.Events[1].(*zendesk.CreateEvent)
+	require.Equal(t, "tags", *create.FieldName)
+	require.JSONEq(t, `["a","b"]`, string(create.Value))
+
+	change := audit.Events[2].(*zendesk.ChangeEvent)
+	require.JSONEq(t, `"solved"`, string(change.Value))
+	require.JSONEq(t, `"open"`, string(change.PreviousValue))
+
+	require.Equal(t, []int64{5, 6}, audit.Events[3].(*zendesk.NotificationEvent).Recipients)
+	require.Equal(t, int64(42), *audit.Events[4].(*zendesk.VoiceCommentEvent).Data.CallDuration)
+	require.Equal(t, "good", *audit.Events[5].(*zendesk.SatisfactionRatingEvent).Score)
+
+	raw := audit.Events[6].(*zendesk.RawAuditEvent)
+	require.Equal(t, "Cc", raw.EventType())
+	require.JSONEq(t, `{"id": 16, "type": "Cc", "recipients": [9]}`, string(raw.Raw))
+
+	types := make([]string, len(audit.Events))
+	for i, event := range audit.Events {
+		types[i] = event.EventType()
+	}
+	require.Equal(t, []string{"Comment", "Create", "Change", "Notification", "VoiceComment", "SatisfactionRating", "Cc"}, types)
+}
+
+func TestTicketAuditEventsRoundTrip(t *testing.T) {
+	var audit zendesk.TicketAudit
+	require.NoError(t, json.Unmarshal([]byte(auditJSON), &audit))
+
+	data, err := json.Marshal(audit)
+	require.NoError(t, err)
+	require.JSONEq(t, auditJSON, string(data))
+
+	var again zendesk.TicketAudit
+	require.NoError(t, json.Unmarshal(data, &again))
+	require.Len(t, again.Events, len(audit.Events))
+
+	twice, err := json.Marshal(again)
+	require.NoError(t, err)
+	require.Equal(t, string(data), string(twice))
+}
+
+func TestTicketAuditEventsMarshalAddsType(t *testing.T) {
+	events := zendesk.TicketAuditEvents{&zendesk.CommentEvent{Body: zendesk.String("Hi")}}
+
+	data, err := json.Marshal(events)
+	require.NoError(t, err)
+	require.JSONEq(t, `[{"type":"Comment","body":"Hi"}]`, string(data))
+}
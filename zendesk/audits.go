@@ -1,10 +1,8 @@
 package zendesk
 
 import (
-	"fmt"
+	"context"
 	"time"
-
-	"github.com/google/go-querystring/query"
 )
 
 // TicketAudit represents an audit on a Ticket.
@@ -16,7 +14,7 @@ type TicketAudit struct {
 	AuthorID  *int64                 `json:"author_id,omitempty"`
 	CreatedAt *time.Time             `json:"created_at,omitempty"`
 	UpdatedAt *time.Time             `json:"updated_at,omitempty"`
-	Events    []interface{}          `json:"events,omitempty"`
+	Events    TicketAuditEvents      `json:"events,omitempty"`
 	Via       *Via                   `json:"via,omitempty"`
 	Metadata  map[string]interface{} `json:"metadata,omitempty"`
 }
@@ -60,13 +58,18 @@ type SourceInfo struct {
 }
 
 func (c *client) ListTicketAudits(ticketID int64, options *ListOptions) (*ListResponse, error) {
-	params, err := query.Values(options)
+	return c.ListTicketAuditsContext(context.Background(), ticketID, options)
+}
+
+// ListTicketAuditsContext is like ListTicketAudits but uses ctx for the underlying request.
+func (c *client) ListTicketAuditsContext(ctx context.Context, ticketID int64, options *ListOptions) (*ListResponse, error) {
+	params, err := listParams(options)
 	if err != nil {
 		return nil, err
 	}
 
 	out := new(APIPayload)
-	err = c.get(fmt.Sprintf("/api/v2/tickets/%d/audits.json?%s", ticketID, params.Encode()), &out)
+	err = c.get(ctx, "ListTicketAudits", routef("/api/v2/tickets/%d/audits.json?%s", ticketID, params.Encode()), &out)
 	if err != nil {
 		return nil, err
 	}
@@ -77,3 +80,36 @@ func (c *client) ListTicketAudits(ticketID int64, options *ListOptions) (*ListRe
 		Count:        out.Count,
 	}, err
 }
+
+// ListTicketAuditsPager is like ListTicketAudits but returns a Pager over all the pages.
+func (c *client) ListTicketAuditsPager(ticketID int64, options *ListOptions) *Pager[TicketAudit] {
+	params, err := listParams(options)
+	rt := routef("/api/v2/tickets/%d/audits.json?%s", ticketID, params.Encode())
+	return newURLPager(c, "ListTicketAuditsPager", rt, err, func(out *APIPayload) []TicketAudit { return out.Audits })
+}
+
+// ListTicketAuditsCursor lists the audits of a ticket using cursor pagination.
+//
+// Zendesk Core API docs: https://developer.zendesk.com/api-reference/introduction/pagination/#using-cursor-pagination
+func (c *client) ListTicketAuditsCursor(ticketID int64, options *CursorOptions) (*ListResponse, error) {
+	return c.ListTicketAuditsCursorContext(context.Background(), ticketID, options)
+}
+
+// ListTicketAuditsCursorContext is like ListTicketAuditsCursor but uses ctx for the underlying request.
+func (c *client) ListTicketAuditsCursorContext(ctx context.Context, ticketID int64, options *CursorOptions) (*ListResponse, error) {
+	params, err := listParams(options)
+	if err != nil {
+		return nil, err
+	}
+
+	out := new(APIPayload)
+	err = c.get(ctx, "ListTicketAuditsCursor", routef("/api/v2/tickets/%d/audits.json?%s", ticketID, params.Encode()), out)
+	if err != nil {
+		return nil, err
+	}
+	return &ListResponse{
+		Audits: out.Audits,
+		Meta:   out.Meta,
+		Links:  out.Links,
+	}, nil
+}
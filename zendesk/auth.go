@@ -0,0 +1,91 @@
+package zendesk
+
+import (
+	"context"
+	"net/http"
+)
+
+// TokenSource supplies the secret used to authenticate requests. It's called
+// before every request, so implementations can refresh or rotate the secret
+// without rebuilding the client.
+type TokenSource interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// TokenSourceFunc is an adapter to allow the use of ordinary functions as a TokenSource.
+type TokenSourceFunc func(ctx context.Context) (string, error)
+
+// Token calls f(ctx).
+func (f TokenSourceFunc) Token(ctx context.Context) (string, error) {
+	return f(ctx)
+}
+
+// StaticToken returns a TokenSource that always returns token.
+func StaticToken(token string) TokenSource {
+	return TokenSourceFunc(func(context.Context) (string, error) {
+		return token, nil
+	})
+}
+
+// authenticator sets the credentials of a request.
+type authenticator func(ctx context.Context, req *http.Request) error
+
+// basicAuth authenticates requests with a user email and password.
+func basicAuth(username, password string) authenticator {
+	return func(_ context.Context, req *http.Request) error {
+		req.SetBasicAuth(username, password)
+		return nil
+	}
+}
+
+// withoutAuth sends the requests without credentials, for the endpoints that
+// authenticate through their payload.
+func withoutAuth() ClientOption {
+	return func(c *client) {
+		c.auth = func(context.Context, *http.Request) error { return nil }
+	}
+}
+
+// WithOAuthToken authenticates requests with an OAuth access token instead of
+// the username and password given to the constructor.
+//
+// Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/support/introduction#oauth-access-token
+func WithOAuthToken(token string) ClientOption {
+	return WithTokenSource(StaticToken(token))
+}
+
+// WithTokenSource authenticates requests with the OAuth access tokens supplied by ts.
+func WithTokenSource(ts TokenSource) ClientOption {
+	return func(c *client) {
+		c.auth = func(ctx context.Context, req *http.Request) error {
+			token, err := ts.Token(ctx)
+			if err != nil {
+				return err
+			}
+			req.Header.Set("Authorization", "Bearer "+token)
+			return nil
+		}
+	}
+}
+
+// WithAPIToken authenticates requests with an API token on behalf of the user
+// with the given email, instead of the username and password given to the constructor.
+//
+// Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/support/introduction#api-token
+func WithAPIToken(email, token string) ClientOption {
+	return WithAPITokenSource(email, StaticToken(token))
+}
+
+// WithAPITokenSource is like WithAPIToken but gets the API token from ts.
+func WithAPITokenSource(email string, ts TokenSource) ClientOption {
+	return func(c *client) {
+		c.auth = func(ctx context.Context, req *http.Request) error {
+			token, err := ts.Token(ctx)
+			if err != nil {
+				return err
+			}
+			req.SetBasicAuth(email+"/token", token)
+			return nil
+		}
+	}
+}
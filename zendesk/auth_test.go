@@ -0,0 +1,73 @@
+package zendesk_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/MEDIGO/go-zendesk/zendesk"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientAuthentication(t *testing.T) {
+	ch := make(chan *http.Request, 1)
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		ch <- r
+		w.Write([]byte(`{}`))
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(handler))
+	defer server.Close()
+
+	// assert that it uses basic auth by default
+	client, err := zendesk.NewURLClient(server.URL, "user@example.com", "secret")
+	require.NoError(t, err)
+	client.ShowLocale(1)
+	username, password, ok := (<-ch).BasicAuth()
+	require.True(t, ok)
+	require.Equal(t, "user@example.com", username)
+	require.Equal(t, "secret", password)
+
+	// assert that it can use an API token
+	client, err = zendesk.NewURLClient(server.URL, "", "", zendesk.WithAPIToken("user@example.com", "token"))
+	require.NoError(t, err)
+	client.ShowLocale(1)
+	username, password, ok = (<-ch).BasicAuth()
+	require.True(t, ok)
+	require.Equal(t, "user@example.com/token", username)
+	require.Equal(t, "token", password)
+
+	// assert that it can use an OAuth token
+	client, err = zendesk.NewURLClient(server.URL, "", "", zendesk.WithOAuthToken("oauth"))
+	require.NoError(t, err)
+	client.ShowLocale(1)
+	require.Equal(t, "Bearer oauth", (<-ch).Header.Get("Authorization"))
+
+	// assert that it asks the token source for every request
+	calls := 0
+	source := zendesk.TokenSourceFunc(func(context.Context) (string, error) {
+		calls++
+		return fmt.Sprintf("token-%d", calls), nil
+	})
+	client, err = zendesk.NewURLClient(server.URL, "", "", zendesk.WithTokenSource(source))
+	require.NoError(t, err)
+	client.ShowLocale(1)
+	require.Equal(t, "Bearer token-1", (<-ch).Header.Get("Authorization"))
+	client.ShowLocale(1)
+	require.Equal(t, "Bearer token-2", (<-ch).Header.Get("Authorization"))
+}
+
+func TestClientTokenSourceError(t *testing.T) {
+	client, err := zendesk.NewURLClient("http://localhost", "", "", zendesk.WithTokenSource(
+		zendesk.TokenSourceFunc(func(context.Context) (string, error) {
+			return "", fmt.Errorf("token expired")
+		}),
+	))
+	require.NoError(t, err)
+
+	_, err = client.ShowLocale(1)
+	require.EqualError(t, err, "token expired")
+}
@@ -0,0 +1,82 @@
+package zendesk_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/MEDIGO/go-zendesk/zendesk"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateManyUsers(t *testing.T) {
+	var method, uri string
+	var body map[string][]map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, uri = r.Method, r.URL.RequestURI()
+		data, _ := io.ReadAll(r.Body)
+		json.Unmarshal(data, &body)
+		fmt.Fprint(w, `{"job_status":{"id":"abc","status":"queued"}}`)
+	}))
+	defer server.Close()
+
+	client, err := zendesk.NewURLClient(server.URL, "", "")
+	require.NoError(t, err)
+
+	jobs, err := client.CreateManyUsers([]zendesk.User{{Name: zendesk.String("One")}, {Name: zendesk.String("Two")}})
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+	require.Equal(t, "abc", *jobs[0].ID)
+	require.Equal(t, "POST", method)
+	require.Equal(t, "/api/v2/users/create_many.json", uri)
+	require.Len(t, body["users"], 2)
+}
+
+func TestDestroyManyTickets(t *testing.T) {
+	var method, uri string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, uri = r.Method, r.URL.RequestURI()
+		fmt.Fprint(w, `{"job_status":{"id":"abc","status":"queued"}}`)
+	}))
+	defer server.Close()
+
+	client, err := zendesk.NewURLClient(server.URL, "", "")
+	require.NoError(t, err)
+
+	jobs, err := client.DestroyManyTickets([]int64{1, 2, 3})
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+	require.Equal(t, "abc", *jobs[0].ID)
+	require.Equal(t, "DELETE", method)
+	require.Equal(t, "/api/v2/tickets/destroy_many.json?ids=1,2,3", uri)
+}
+
+func TestBulkChunks(t *testing.T) {
+	var mu sync.Mutex
+	var sizes []int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string][]json.RawMessage
+		data, _ := io.ReadAll(r.Body)
+		json.Unmarshal(data, &body)
+
+		mu.Lock()
+		sizes = append(sizes, len(body["organizations"]))
+		mu.Unlock()
+
+		fmt.Fprintf(w, `{"job_status":{"id":"job-%d"}}`, len(body["organizations"]))
+	}))
+	defer server.Close()
+
+	client, err := zendesk.NewURLClient(server.URL, "", "")
+	require.NoError(t, err)
+
+	jobs, err := client.UpdateManyOrganizations(make([]zendesk.Organization, 250))
+	require.NoError(t, err)
+	require.Len(t, jobs, 3)
+	require.Equal(t, "job-50", *jobs[2].ID)
+	require.ElementsMatch(t, []int{100, 100, 50}, sizes)
+}
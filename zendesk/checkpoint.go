@@ -0,0 +1,145 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// Checkpoint records how far an incremental export has progressed.
+type Checkpoint struct {
+	// Cursor is the cursor to resume a cursor-based export from.
+	Cursor string `json:"cursor,omitempty"`
+	// StartTime is the Unix epoch time to resume a time-based export from.
+	StartTime int64 `json:"start_time,omitempty"`
+}
+
+// CheckpointStore persists the checkpoints of the exports, identified by a key.
+type CheckpointStore interface {
+	// Load returns the checkpoint saved under key, or nil if there is none.
+	Load(ctx context.Context, key string) (*Checkpoint, error)
+	// Save replaces the checkpoint saved under key.
+	Save(ctx context.Context, key string, checkpoint Checkpoint) error
+}
+
+// MemoryCheckpointStore is a CheckpointStore that keeps the checkpoints in memory.
+// It's safe for concurrent use.
+type MemoryCheckpointStore struct {
+	mu          sync.Mutex
+	checkpoints map[string]Checkpoint
+}
+
+// NewMemoryCheckpointStore returns an empty MemoryCheckpointStore.
+func NewMemoryCheckpointStore() *MemoryCheckpointStore {
+	return &MemoryCheckpointStore{checkpoints: make(map[string]Checkpoint)}
+}
+
+// Load implements CheckpointStore.
+func (s *MemoryCheckpointStore) Load(_ context.Context, key string) (*Checkpoint, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	checkpoint, ok := s.checkpoints[key]
+	if !ok {
+		return nil, nil
+	}
+	return &checkpoint, nil
+}
+
+// Save implements CheckpointStore.
+func (s *MemoryCheckpointStore) Save(_ context.Context, key string, checkpoint Checkpoint) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.checkpoints[key] = checkpoint
+	return nil
+}
+
+// FileCheckpointStore is a CheckpointStore that keeps the checkpoints in a JSON
+// file. The file is replaced atomically on every save, so that a crash never
+// leaves it half written. It's safe for concurrent use within a process.
+type FileCheckpointStore struct {
+	mu   sync.Mutex
+	path string
+}
+
+// NewFileCheckpointStore returns a FileCheckpointStore backed by the file at path,
+// which is created on the first save.
+func NewFileCheckpointStore(path string) *FileCheckpointStore {
+	return &FileCheckpointStore{path: path}
+}
+
+// Load implements CheckpointStore.
+func (s *FileCheckpointStore) Load(_ context.Context, key string) (*Checkpoint, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	checkpoints, err := s.read()
+	if err != nil {
+		return nil, err
+	}
+
+	checkpoint, ok := checkpoints[key]
+	if !ok {
+		return nil, nil
+	}
+	return &checkpoint, nil
+}
+
+// Save implements CheckpointStore.
+func (s *FileCheckpointStore) Save(_ context.Context, key string, checkpoint Checkpoint) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	checkpoints, err := s.read()
+	if err != nil {
+		return err
+	}
+	checkpoints[key] = checkpoint
+
+	data, err := json.MarshalIndent(checkpoints, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), s.path)
+}
+
+func (s *FileCheckpointStore) read() (map[string]Checkpoint, error) {
+	checkpoints := make(map[string]Checkpoint)
+
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return checkpoints, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, &checkpoints); err != nil {
+		return nil, err
+	}
+	return checkpoints, nil
+}
@@ -0,0 +1,188 @@
+package zendesk
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	// maxChunkSize is the largest number of items accepted by the show_many,
+	// create_many, update_many and destroy_many endpoints.
+	maxChunkSize = 100
+	// defaultChunkConcurrency is the number of chunks sent at the same time by default.
+	defaultChunkConcurrency = 4
+)
+
+// WithChunkConcurrency sets how many chunks of a chunked request are sent at the
+// same time. Defaults to 4.
+//
+// Methods such as ShowManyUsers split their input into chunks of 100 items, the
+// most Zendesk accepts in a single request. The bulk methods, such as
+// CreateManyUsers, start a background job for each chunk and return the status
+// of the jobs in chunk order. When they're given a context from
+// CaptureResponse, the metadata of the response to the last chunk is captured.
+func WithChunkConcurrency(n int) ClientOption {
+	return func(c *client) {
+		c.chunkConcurrency = n
+	}
+}
+
+// ChunkError reports the failure of one chunk of a chunked request.
+type ChunkError struct {
+	// Start and End delimit the input items of the chunk, End excluded.
+	Start, End int
+	Err        error
+}
+
+func (e *ChunkError) Error() string {
+	return fmt.Sprintf("zendesk: chunk [%d:%d]: %v", e.Start, e.End, e.Err)
+}
+
+func (e *ChunkError) Unwrap() error {
+	return e.Err
+}
+
+// ChunkErrors is returned by the chunked methods when some of their chunks
+// failed. The results of the other chunks are still returned.
+type ChunkErrors []*ChunkError
+
+func (e ChunkErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+func (e ChunkErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, err := range e {
+		errs[i] = err
+	}
+	return errs
+}
+
+// chunked calls fn on chunks of at most maxChunkSize items of in, with bounded
+// concurrency, and merges the results in chunk order. The results of a chunk are
+// kept in the order fn returns them, use sortByInput to restore the order of
+// the items. Inputs that fit in a single chunk are passed to fn as is and its
+// error is returned unchanged.
+func chunked[In, Out any](ctx context.Context, c *client, in []In, fn func(context.Context, []In) ([]Out, error)) ([]Out, error) {
+	if len(in) <= maxChunkSize {
+		return fn(ctx, in)
+	}
+
+	concurrency := c.chunkConcurrency
+	if concurrency <= 0 {
+		concurrency = defaultChunkConcurrency
+	}
+
+	chunks := (len(in) + maxChunkSize - 1) / maxChunkSize
+	results := make([][]Out, chunks)
+	errs := make([]*ChunkError, chunks)
+
+	// The chunks capture their response separately, as they run concurrently.
+	captured, _ := ctx.Value(responseKey{}).(*Response)
+	responses := make([]Response, chunks)
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+	for i := 0; i < chunks; i++ {
+		start, end := i*maxChunkSize, (i+1)*maxChunkSize
+		if end > len(in) {
+			end = len(in)
+		}
+
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(i, start, end int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			chunkCtx := ctx
+			if captured != nil {
+				chunkCtx = CaptureResponse(ctx, &responses[i])
+			}
+
+			out, err := fn(chunkCtx, in[start:end])
+			if err != nil {
+				errs[i] = &ChunkError{Start: start, End: end, Err: err}
+				return
+			}
+			results[i] = out
+		}(i, start, end)
+	}
+	wg.Wait()
+
+	if captured != nil {
+		for i := len(responses) - 1; i >= 0; i-- {
+			if responses[i].StatusCode != 0 {
+				*captured = responses[i]
+				break
+			}
+		}
+	}
+
+	var merged []Out
+	var failed ChunkErrors
+	for i := range results {
+		if errs[i] != nil {
+			failed = append(failed, errs[i])
+			continue
+		}
+		merged = append(merged, results[i]...)
+	}
+
+	if len(failed) > 0 {
+		return merged, failed
+	}
+	return merged, nil
+}
+
+// sortByInput sorts records in the order of the input keys they were fetched
+// by, as given by key. The records without a key among keys come last, in the
+// order they were in.
+func sortByInput[K comparable, T any](records []T, keys []K, key func(*T) (K, bool)) {
+	pos := make(map[K]int, len(keys))
+	for i, k := range keys {
+		if _, ok := pos[k]; !ok {
+			pos[k] = i
+		}
+	}
+
+	index := func(record *T) int {
+		if k, ok := key(record); ok {
+			if i, ok := pos[k]; ok {
+				return i
+			}
+		}
+		return len(keys)
+	}
+	slices.SortStableFunc(records, func(a, b T) int {
+		return cmp.Compare(index(&a), index(&b))
+	})
+}
+
+// jobStatuses returns the job status of out as the result of a chunk.
+func jobStatuses(out *APIPayload, err error) ([]JobStatus, error) {
+	if err != nil || out.JobStatus == nil {
+		return nil, err
+	}
+	return []JobStatus{*out.JobStatus}, nil
+}
+
+// joinIDs formats ids as a comma-separated list.
+func joinIDs(ids []int64) string {
+	sids := make([]string, len(ids))
+	for i, id := range ids {
+		sids[i] = strconv.FormatInt(id, 10)
+	}
+	return strings.Join(sids, ",")
+}
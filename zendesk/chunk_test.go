@@ -0,0 +1,196 @@
+package zendesk_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/MEDIGO/go-zendesk/zendesk"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShowManyUsersChunks(t *testing.T) {
+	var mu sync.Mutex
+	var sizes []int
+	var active, maxActive int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ids := strings.Split(r.URL.Query().Get("ids"), ",")
+
+		mu.Lock()
+		sizes = append(sizes, len(ids))
+		active++
+		if active > maxActive {
+			maxActive = active
+		}
+		mu.Unlock()
+		defer func() {
+			mu.Lock()
+			active--
+			mu.Unlock()
+		}()
+
+		// Zendesk doesn't return the users in the order of the ids.
+		rand.Shuffle(len(ids), func(i, j int) { ids[i], ids[j] = ids[j], ids[i] })
+
+		users := make([]string, len(ids))
+		for i, id := range ids {
+			users[i] = fmt.Sprintf(`{"id":%s}`, id)
+		}
+		fmt.Fprintf(w, `{"users":[%s]}`, strings.Join(users, ","))
+	}))
+	defer server.Close()
+
+	client, err := zendesk.NewURLClient(server.URL, "", "", zendesk.WithChunkConcurrency(2))
+	require.NoError(t, err)
+
+	ids := make([]int64, 250)
+	for i := range ids {
+		ids[i] = int64(i + 1)
+	}
+
+	users, err := client.ShowManyUsers(ids)
+	require.NoError(t, err)
+	require.Len(t, users, 250)
+	for i, user := range users {
+		require.Equal(t, ids[i], *user.ID)
+	}
+
+	require.ElementsMatch(t, []int{100, 100, 50}, sizes)
+	require.LessOrEqual(t, maxActive, 2)
+}
+
+func TestShowManyUsersByExternalIDsOrder(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ids := strings.Split(r.URL.Query().Get("external_ids"), ",")
+		rand.Shuffle(len(ids), func(i, j int) { ids[i], ids[j] = ids[j], ids[i] })
+
+		users := make([]string, len(ids))
+		for i, id := range ids {
+			users[i] = fmt.Sprintf(`{"external_id":%q}`, id)
+		}
+		fmt.Fprintf(w, `{"users":[%s]}`, strings.Join(users, ","))
+	}))
+	defer server.Close()
+
+	client, err := zendesk.NewURLClient(server.URL, "", "")
+	require.NoError(t, err)
+
+	externalIDs := make([]string, 150)
+	for i := range externalIDs {
+		externalIDs[i] = fmt.Sprintf("ext-%d", len(externalIDs)-i)
+	}
+
+	users, err := client.ShowManyUsersByExternalIDs(externalIDs)
+	require.NoError(t, err)
+	require.Len(t, users, 150)
+	for i, user := range users {
+		require.Equal(t, externalIDs[i], *user.ExternalID)
+	}
+}
+
+func TestShowManyOrganizationsChunkErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ids := strings.Split(r.URL.Query().Get("ids"), ",")
+		if ids[0] == "101" {
+			w.WriteHeader(http.StatusInternalServerError)
+			fmt.Fprint(w, `{"error":"InternalError"}`)
+			return
+		}
+		fmt.Fprintf(w, `{"organizations":[{"id":%s}]}`, ids[0])
+	}))
+	defer server.Close()
+
+	client, err := zendesk.NewURLClient(server.URL, "", "", zendesk.WithRetryPolicy(zendesk.RetryPolicy{MaxAttempts: 1}))
+	require.NoError(t, err)
+
+	ids := make([]int64, 300)
+	for i := range ids {
+		ids[i] = int64(i + 1)
+	}
+
+	orgs, err := client.ShowManyOrganizations(ids)
+	require.Len(t, orgs, 2)
+	require.Equal(t, int64(1), *orgs[0].ID)
+	require.Equal(t, int64(201), *orgs[1].ID)
+
+	var chunkErrs zendesk.ChunkErrors
+	require.True(t, errors.As(err, &chunkErrs))
+	require.Len(t, chunkErrs, 1)
+	require.Equal(t, 100, chunkErrs[0].Start)
+	require.Equal(t, 200, chunkErrs[0].End)
+
+	var apiErr *zendesk.APIError
+	require.True(t, errors.As(err, &apiErr))
+	require.Equal(t, http.StatusInternalServerError, apiErr.StatusCode())
+}
+
+func TestBulkUpdateManyTicketsSingleChunk(t *testing.T) {
+	var requested []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = append(requested, r.URL.RequestURI())
+		fmt.Fprint(w, `{"job_status":{"id":"abc"}}`)
+	}))
+	defer server.Close()
+
+	client, err := zendesk.NewURLClient(server.URL, "", "")
+	require.NoError(t, err)
+
+	_, err = client.BulkUpdateManyTickets([]int64{1, 2}, &zendesk.Ticket{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"/api/v2/tickets/update_many.json?ids=1,2"}, requested)
+}
+
+func TestBulkUpdateManyTicketsChunks(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ids := strings.Split(r.URL.Query().Get("ids"), ",")
+		fmt.Fprintf(w, `{"job_status":{"id":"job-%s"}}`, ids[0])
+	}))
+	defer server.Close()
+
+	client, err := zendesk.NewURLClient(server.URL, "", "")
+	require.NoError(t, err)
+
+	ids := make([]int64, 250)
+	for i := range ids {
+		ids[i] = int64(i + 1)
+	}
+
+	statuses, err := client.BulkUpdateManyTickets(ids, &zendesk.Ticket{})
+	require.NoError(t, err)
+	require.Len(t, statuses, 3)
+	for i, id := range []string{"job-1", "job-101", "job-201"} {
+		require.Equal(t, id, *statuses[i].ID)
+	}
+}
+
+func TestShowManyUsersCaptureResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ids := strings.Split(r.URL.Query().Get("ids"), ",")
+		w.Header().Set("X-Request-Id", "chunk-"+ids[0])
+		fmt.Fprint(w, `{"users":[]}`)
+	}))
+	defer server.Close()
+
+	client, err := zendesk.NewURLClient(server.URL, "", "")
+	require.NoError(t, err)
+
+	ids := make([]int64, 250)
+	for i := range ids {
+		ids[i] = int64(i + 1)
+	}
+
+	var res zendesk.Response
+	_, err = client.ShowManyUsersContext(zendesk.CaptureResponse(context.Background(), &res), ids)
+	require.NoError(t, err)
+
+	// assert that the response to the last chunk is captured
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	require.Equal(t, "chunk-201", res.RequestID)
+}
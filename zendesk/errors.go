@@ -0,0 +1,69 @@
+package zendesk
+
+import (
+	"errors"
+	"net/http"
+)
+
+// FieldErrors maps the name of each invalid field to the errors reported for it.
+type FieldErrors map[string][]*APIErrorDetail
+
+// StatusCode returns the HTTP status code of the response, or 0 if the error
+// isn't attached to a response.
+func (e *APIError) StatusCode() int {
+	if e.Response == nil {
+		return 0
+	}
+	return e.Response.StatusCode
+}
+
+// FieldErrors returns the details of the error keyed by field name. It returns
+// nil when the error has no details.
+func (e *APIError) FieldErrors() FieldErrors {
+	if e.Details == nil {
+		return nil
+	}
+	return FieldErrors(*e.Details)
+}
+
+// IsNotFound reports whether err is an APIError for a missing record.
+func IsNotFound(err error) bool {
+	return isAPIError(err, http.StatusNotFound, "RecordNotFound")
+}
+
+// IsRateLimited reports whether err is an APIError for a rate-limited request.
+func IsRateLimited(err error) bool {
+	return isAPIError(err, http.StatusTooManyRequests, "")
+}
+
+// IsValidation reports whether err is an APIError for a record that failed validation.
+// The invalid fields are available through the FieldErrors method of the APIError.
+func IsValidation(err error) bool {
+	return isAPIError(err, http.StatusUnprocessableEntity, "RecordInvalid")
+}
+
+// IsUnauthorized reports whether err is an APIError for a request with missing or invalid credentials.
+func IsUnauthorized(err error) bool {
+	return isAPIError(err, http.StatusUnauthorized, "")
+}
+
+// IsConflict reports whether err is an APIError for a request conflicting with
+// the state of a record, such as a concurrent update.
+func IsConflict(err error) bool {
+	return isAPIError(err, http.StatusConflict, "")
+}
+
+// isAPIError reports whether err wraps an APIError with the given status code,
+// or with the given error type when the error isn't attached to a response.
+func isAPIError(err error, statusCode int, errorType string) bool {
+	var apierr *APIError
+	if !errors.As(err, &apierr) {
+		return false
+	}
+
+	if apierr.Response != nil {
+		return apierr.Response.StatusCode == statusCode
+	}
+
+	return errorType != "" && apierr.Type != nil && *apierr.Type == errorType
+}
@@ -0,0 +1,51 @@
+package zendesk
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIErrorClassification(t *testing.T) {
+	newErr := func(code int) error {
+		return fmt.Errorf("wrapped: %w", &APIError{Response: &http.Response{StatusCode: code}})
+	}
+
+	require.True(t, IsNotFound(newErr(http.StatusNotFound)))
+	require.True(t, IsRateLimited(newErr(http.StatusTooManyRequests)))
+	require.True(t, IsValidation(newErr(http.StatusUnprocessableEntity)))
+	require.True(t, IsUnauthorized(newErr(http.StatusUnauthorized)))
+	require.True(t, IsConflict(newErr(http.StatusConflict)))
+
+	require.False(t, IsNotFound(newErr(http.StatusConflict)))
+	require.False(t, IsNotFound(fmt.Errorf("not an api error")))
+	require.False(t, IsNotFound(nil))
+
+	// assert that errors without a response are classified by their type
+	require.True(t, IsNotFound(&APIError{Type: String("RecordNotFound")}))
+	require.True(t, IsValidation(&APIError{Type: String("RecordInvalid")}))
+}
+
+func TestAPIErrorWithoutResponse(t *testing.T) {
+	err := &APIError{
+		Type:        String("RecordInvalid"),
+		Description: String("Record validation errors"),
+		Details: &map[string][]*APIErrorDetail{
+			"email": {{Type: String("InvalidValue"), Description: String("Email is invalid")}},
+		},
+	}
+
+	require.NotPanics(t, func() { _ = err.Error() })
+	require.Contains(t, err.Error(), "RecordInvalid")
+	require.Equal(t, 0, err.StatusCode())
+
+	fields := err.FieldErrors()
+	require.Len(t, fields["email"], 1)
+	require.Equal(t, "InvalidValue: Email is invalid", fields["email"][0].Error())
+
+	err.Response = &http.Response{StatusCode: http.StatusUnprocessableEntity}
+	require.NotPanics(t, func() { _ = err.Error() })
+	require.Equal(t, http.StatusUnprocessableEntity, err.StatusCode())
+}
@@ -0,0 +1,252 @@
+package zendesk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ErrExportStalled is returned by Exporter.Run when more records share a single
+// timestamp than fit in a page of a time-based export, which can't move past
+// them without skipping some.
+var ErrExportStalled = errors.New("zendesk: export stalled on a full page of records sharing a timestamp")
+
+// ExportResource identifies the records walked by an Exporter.
+type ExportResource string
+
+const (
+	ExportTickets            ExportResource = "tickets"
+	ExportUsers              ExportResource = "users"
+	ExportOrganizations      ExportResource = "organizations"
+	ExportTicketEvents       ExportResource = "ticket_events"
+	ExportTicketMetricEvents ExportResource = "ticket_metric_events"
+)
+
+const (
+	// maxExportPerPage is the largest page the incremental exports return.
+	maxExportPerPage = 1000
+	// defaultExportRequestsPerMinute is the rate limit of the incremental exports.
+	defaultExportRequestsPerMinute = 10
+)
+
+// Exporter walks an incremental export until the end of the stream, saving its
+// progress to a CheckpointStore after every page so that an interrupted export
+// resumes from the last page it completed.
+//
+//	exporter := &zendesk.Exporter{
+//		Client:    client,
+//		Resource:  zendesk.ExportTickets,
+//		Store:     zendesk.NewFileCheckpointStore("checkpoints.json"),
+//		SideLoads: []zendesk.SideLoad{zendesk.IncludeUsers()},
+//	}
+//	err := exporter.Run(ctx, func(page *zendesk.IncrementalExport) error {
+//		return warehouse.Insert(page.Tickets, page.Users)
+//	})
+type Exporter struct {
+	// Client is used to fetch the pages.
+	Client ContextClient
+	// Resource is the kind of records to export.
+	Resource ExportResource
+	// Store persists the checkpoints. When nil, the checkpoints of each run are
+	// only kept in memory.
+	Store CheckpointStore
+	// Key identifies the checkpoint of the export in Store. Defaults to Resource.
+	Key string
+	// StartTime is the Unix epoch time to start from when Store holds no checkpoint.
+	StartTime int64
+	// PerPage sets the number of records per page, up to 1000.
+	PerPage int
+	// SideLoads lists the side-loads to request with every page.
+	SideLoads []SideLoad
+	// RequestsPerMinute paces the requests of the export. Defaults to 10, the
+	// rate limit of the incremental exports.
+	RequestsPerMinute int
+}
+
+// Run fetches the pages of the export and passes them to fn, in order, until
+// the end of the stream, an error, or the cancellation of ctx. The checkpoint is
+// only saved once fn returns successfully, so a page may be passed to fn again
+// after a crash, but never skipped. Rate-limited requests are retried after the
+// delay given by Zendesk, up to a few times.
+//
+// A time-based export whose page is full of records sharing its start time is
+// fetched again with the largest page size, and fails with ErrExportStalled
+// when the largest page isn't enough to move past them.
+func (e *Exporter) Run(ctx context.Context, fn func(*IncrementalExport) error) error {
+	fetch, cursorBased, err := e.fetcher()
+	if err != nil {
+		return err
+	}
+
+	store := e.Store
+	if store == nil {
+		store = NewMemoryCheckpointStore()
+	}
+
+	key := e.Key
+	if key == "" {
+		key = string(e.Resource)
+	}
+
+	perMinute := e.RequestsPerMinute
+	if perMinute <= 0 {
+		perMinute = defaultExportRequestsPerMinute
+	}
+	limiter := newRateLimiter(perMinute)
+
+	checkpoint, err := store.Load(ctx, key)
+	if err != nil {
+		return fmt.Errorf("zendesk: loading export checkpoint: %w", err)
+	}
+	if checkpoint == nil {
+		checkpoint = &Checkpoint{StartTime: e.StartTime}
+	}
+
+	options := &IncrementalOptions{PerPage: e.PerPage}
+	if options.PerPage > maxExportPerPage {
+		options.PerPage = maxExportPerPage
+	}
+
+	for {
+		options.StartTime, options.Cursor = checkpoint.StartTime, checkpoint.Cursor
+
+		page, err := e.fetchPage(ctx, limiter, fetch, options)
+		if err != nil {
+			return err
+		}
+
+		done := page.EndOfStream
+		next := *checkpoint
+		if cursorBased {
+			if page.AfterCursor != nil {
+				next.Cursor = *page.AfterCursor
+			}
+		} else {
+			done = done || page.NextPage == nil
+			if page.EndTime != nil {
+				next.StartTime = *page.EndTime
+			}
+
+			// The metric events export doesn't report end_of_stream: it ends
+			// with a page without events or that doesn't move past its start.
+			if e.Resource == ExportTicketMetricEvents {
+				done = done || len(page.TicketMetricEvents) == 0 || next.StartTime <= checkpoint.StartTime
+			}
+
+			// A page full of records sharing the start time ends where it
+			// started. Fetching it again would return the same records, and
+			// moving past the start time would skip the ones beyond the page.
+			if !done && next.StartTime <= checkpoint.StartTime {
+				if options.PerPage <= 0 || options.PerPage >= maxExportPerPage {
+					return fmt.Errorf("%w: start time %d", ErrExportStalled, checkpoint.StartTime)
+				}
+				options.PerPage = maxExportPerPage
+				continue
+			}
+		}
+
+		if err := fn(page); err != nil {
+			return err
+		}
+
+		if err := store.Save(ctx, key, next); err != nil {
+			return fmt.Errorf("zendesk: saving export checkpoint: %w", err)
+		}
+		checkpoint = &next
+
+		if done {
+			return nil
+		}
+	}
+}
+
+// ExportPage is a page sent by Exporter.Stream.
+type ExportPage struct {
+	*IncrementalExport
+
+	ack  chan struct{}
+	once sync.Once
+}
+
+// Ack reports that the page has been processed, which saves its checkpoint and
+// lets the export fetch the next page.
+func (p *ExportPage) Ack() {
+	p.once.Do(func() { close(p.ack) })
+}
+
+// Stream is like Run but sends the pages over the returned channel, which is
+// closed at the end of the export. The error channel then receives the error
+// that stopped the export, if any. Each page must be acknowledged with its Ack
+// method once processed: the export saves its checkpoint and moves on to the
+// next page only then.
+func (e *Exporter) Stream(ctx context.Context) (<-chan *ExportPage, <-chan error) {
+	pages := make(chan *ExportPage)
+	errc := make(chan error, 1)
+
+	go func() {
+		defer close(errc)
+		defer close(pages)
+
+		err := e.Run(ctx, func(export *IncrementalExport) error {
+			page := &ExportPage{IncrementalExport: export, ack: make(chan struct{})}
+
+			select {
+			case pages <- page:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+
+			select {
+			case <-page.ack:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+		if err != nil {
+			errc <- err
+		}
+	}()
+
+	return pages, errc
+}
+
+type exportFetcher func(ctx context.Context, options *IncrementalOptions, sideloads ...SideLoad) (*IncrementalExport, error)
+
+// fetcher returns the method fetching the pages of the resource, and whether
+// the export is cursor-based rather than time-based.
+func (e *Exporter) fetcher() (exportFetcher, bool, error) {
+	switch e.Resource {
+	case ExportTickets:
+		return e.Client.IncrementalTicketsContext, true, nil
+	case ExportUsers:
+		return e.Client.IncrementalUsersContext, true, nil
+	case ExportOrganizations:
+		return e.Client.IncrementalOrganizationsContext, false, nil
+	case ExportTicketEvents:
+		return e.Client.IncrementalTicketEventsContext, false, nil
+	case ExportTicketMetricEvents:
+		return func(ctx context.Context, options *IncrementalOptions, _ ...SideLoad) (*IncrementalExport, error) {
+			return e.Client.IncrementalTicketMetricEventsContext(ctx, options)
+		}, false, nil
+	default:
+		return nil, false, fmt.Errorf("zendesk: unsupported export resource %q", e.Resource)
+	}
+}
+
+// fetchPage fetches a page at the pace of limiter, waiting out the rate limits
+// reported by Zendesk.
+func (e *Exporter) fetchPage(ctx context.Context, limiter *rateLimiter, fetch exportFetcher, options *IncrementalOptions) (*IncrementalExport, error) {
+	var page *IncrementalExport
+	err := withRateLimitRetry(ctx, func() error {
+		if err := limiter.wait(ctx); err != nil {
+			return err
+		}
+
+		var err error
+		page, err = fetch(ctx, options, e.SideLoads...)
+		return err
+	})
+	return page, err
+}
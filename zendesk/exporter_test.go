@@ -0,0 +1,272 @@
+package zendesk_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/MEDIGO/go-zendesk/zendesk"
+	"github.com/stretchr/testify/require"
+)
+
+// newCursorExportServer serves a cursor-based ticket export of the given number
+// of pages, whose cursors are the page numbers.
+func newCursorExportServer(t *testing.T, pages int, requested *[]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requested = append(*requested, r.URL.RequestURI())
+
+		page := 0
+		fmt.Sscan(r.URL.Query().Get("cursor"), &page)
+		page++
+
+		fmt.Fprintf(w, `{"tickets":[{"id":%d}],"after_cursor":"%d","end_of_stream":%t}`, page, page, page >= pages)
+	}))
+}
+
+func TestExporterResumesFromCheckpoint(t *testing.T) {
+	var requested []string
+	server := newCursorExportServer(t, 3, &requested)
+	defer server.Close()
+
+	client, err := zendesk.NewURLClient(server.URL, "", "")
+	require.NoError(t, err)
+
+	store := zendesk.NewFileCheckpointStore(filepath.Join(t.TempDir(), "checkpoints.json"))
+	exporter := &zendesk.Exporter{
+		Client:            client,
+		Resource:          zendesk.ExportTickets,
+		Store:             store,
+		StartTime:         1500000000,
+		PerPage:           5000,
+		RequestsPerMinute: 60000,
+	}
+
+	crash := errors.New("crash")
+	var ids []int64
+	err = exporter.Run(context.Background(), func(page *zendesk.IncrementalExport) error {
+		if *page.Tickets[0].ID == 2 {
+			return crash
+		}
+		ids = append(ids, *page.Tickets[0].ID)
+		return nil
+	})
+	require.Equal(t, crash, err)
+
+	checkpoint, err := store.Load(context.Background(), "tickets")
+	require.NoError(t, err)
+	require.Equal(t, &zendesk.Checkpoint{Cursor: "1", StartTime: 1500000000}, checkpoint)
+
+	err = exporter.Run(context.Background(), func(page *zendesk.IncrementalExport) error {
+		ids = append(ids, *page.Tickets[0].ID)
+		return nil
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, []int64{1, 2, 3}, ids)
+	require.Equal(t, []string{
+		"/api/v2/incremental/tickets/cursor.json?per_page=1000&start_time=1500000000",
+		"/api/v2/incremental/tickets/cursor.json?cursor=1&per_page=1000",
+		"/api/v2/incremental/tickets/cursor.json?cursor=1&per_page=1000",
+		"/api/v2/incremental/tickets/cursor.json?cursor=2&per_page=1000",
+	}, requested)
+}
+
+func TestExporterTimeBased(t *testing.T) {
+	var requested []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = append(requested, r.URL.RequestURI())
+
+		switch r.URL.Query().Get("start_time") {
+		case "0":
+			fmt.Fprint(w, `{"organizations":[{"id":1}],"end_time":100,"next_page":"next","end_of_stream":false}`)
+		case "100":
+			if r.URL.Query().Get("per_page") == "2" {
+				// A full page sharing the start time must be fetched again with a larger page.
+				fmt.Fprint(w, `{"organizations":[{"id":2},{"id":3}],"end_time":100,"next_page":"next","end_of_stream":false}`)
+				return
+			}
+			fmt.Fprint(w, `{"organizations":[{"id":2},{"id":3},{"id":4}],"end_time":150,"next_page":"next","end_of_stream":false}`)
+		default:
+			fmt.Fprint(w, `{"organizations":[{"id":5}],"end_time":200,"next_page":"next","end_of_stream":true}`)
+		}
+	}))
+	defer server.Close()
+
+	client, err := zendesk.NewURLClient(server.URL, "", "")
+	require.NoError(t, err)
+
+	store := zendesk.NewMemoryCheckpointStore()
+	exporter := &zendesk.Exporter{Client: client, Resource: zendesk.ExportOrganizations, Store: store, PerPage: 2, RequestsPerMinute: 60000}
+
+	var ids []int64
+	require.NoError(t, exporter.Run(context.Background(), func(page *zendesk.IncrementalExport) error {
+		for _, org := range page.Organizations {
+			ids = append(ids, *org.ID)
+		}
+		return nil
+	}))
+
+	require.Equal(t, []int64{1, 2, 3, 4, 5}, ids)
+	require.Equal(t, []string{
+		"/api/v2/incremental/organizations.json?per_page=2&start_time=0",
+		"/api/v2/incremental/organizations.json?per_page=2&start_time=100",
+		"/api/v2/incremental/organizations.json?per_page=1000&start_time=100",
+		"/api/v2/incremental/organizations.json?per_page=1000&start_time=150",
+	}, requested)
+
+	checkpoint, err := store.Load(context.Background(), "organizations")
+	require.NoError(t, err)
+	require.Equal(t, int64(200), checkpoint.StartTime)
+}
+
+func TestExporterStalled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"ticket_events":[{"id":1}],"end_time":100,"next_page":"next","end_of_stream":false}`)
+	}))
+	defer server.Close()
+
+	client, err := zendesk.NewURLClient(server.URL, "", "")
+	require.NoError(t, err)
+
+	store := zendesk.NewMemoryCheckpointStore()
+	exporter := &zendesk.Exporter{Client: client, Resource: zendesk.ExportTicketEvents, Store: store, StartTime: 100, RequestsPerMinute: 60000}
+
+	err = exporter.Run(context.Background(), func(page *zendesk.IncrementalExport) error {
+		t.Fatal("expected the stalled page to be left out")
+		return nil
+	})
+	require.ErrorIs(t, err, zendesk.ErrExportStalled)
+
+	checkpoint, err := store.Load(context.Background(), "ticket_events")
+	require.NoError(t, err)
+	require.Nil(t, checkpoint, "expected the checkpoint to be left untouched")
+}
+
+func TestExporterTicketMetricEventsEnd(t *testing.T) {
+	for _, empty := range []bool{true, false} {
+		var requested []string
+		server := newMetricEventsServer(t, empty, &requested)
+		defer server.Close()
+
+		client, err := zendesk.NewURLClient(server.URL, "", "")
+		require.NoError(t, err)
+
+		store := zendesk.NewMemoryCheckpointStore()
+		exporter := &zendesk.Exporter{Client: client, Resource: zendesk.ExportTicketMetricEvents, Store: store, StartTime: 100, RequestsPerMinute: 60000}
+
+		var ids []int64
+		require.NoError(t, exporter.Run(context.Background(), func(page *zendesk.IncrementalExport) error {
+			for _, event := range page.TicketMetricEvents {
+				ids = append(ids, *event.ID)
+			}
+			return nil
+		}))
+
+		require.Len(t, requested, 3)
+		if empty {
+			require.Equal(t, []int64{100, 200}, ids)
+		} else {
+			require.Equal(t, []int64{100, 200, 300}, ids)
+		}
+
+		checkpoint, err := store.Load(context.Background(), "ticket_metric_events")
+		require.NoError(t, err)
+		require.Equal(t, int64(300), checkpoint.StartTime)
+	}
+}
+
+func TestExporterStreamAck(t *testing.T) {
+	var requested []string
+	server := newCursorExportServer(t, 2, &requested)
+	defer server.Close()
+
+	client, err := zendesk.NewURLClient(server.URL, "", "")
+	require.NoError(t, err)
+
+	store := zendesk.NewMemoryCheckpointStore()
+	exporter := &zendesk.Exporter{Client: client, Resource: zendesk.ExportTickets, Store: store, RequestsPerMinute: 60000}
+
+	pages, errc := exporter.Stream(context.Background())
+
+	page := <-pages
+	require.Equal(t, int64(1), *page.Tickets[0].ID)
+
+	// assert that the checkpoint of a received page is only saved once it's acknowledged
+	checkpoint, err := store.Load(context.Background(), "tickets")
+	require.NoError(t, err)
+	require.Nil(t, checkpoint)
+
+	page.Ack()
+	page = <-pages
+	require.Equal(t, int64(2), *page.Tickets[0].ID)
+
+	checkpoint, err = store.Load(context.Background(), "tickets")
+	require.NoError(t, err)
+	require.Equal(t, "1", checkpoint.Cursor)
+
+	page.Ack()
+	_, ok := <-pages
+	require.False(t, ok)
+	require.NoError(t, <-errc)
+
+	checkpoint, err = store.Load(context.Background(), "tickets")
+	require.NoError(t, err)
+	require.Equal(t, "2", checkpoint.Cursor)
+}
+
+func TestExporterRateLimited(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		fmt.Fprint(w, `{"users":[{"id":1}],"after_cursor":"a","end_of_stream":true}`)
+	}))
+	defer server.Close()
+
+	client, err := zendesk.NewURLClient(server.URL, "", "", zendesk.WithRetryPolicy(zendesk.RetryPolicy{MaxAttempts: 1}))
+	require.NoError(t, err)
+
+	exporter := &zendesk.Exporter{Client: client, Resource: zendesk.ExportUsers, RequestsPerMinute: 60000}
+
+	pages, errc := exporter.Stream(context.Background())
+
+	var count int
+	for page := range pages {
+		require.Len(t, page.Users, 1)
+		page.Ack()
+		count++
+	}
+	require.NoError(t, <-errc)
+	require.Equal(t, 1, count)
+	require.Equal(t, int32(2), atomic.LoadInt32(&calls))
+}
+
+func TestExporterCancel(t *testing.T) {
+	var requested []string
+	server := newCursorExportServer(t, 100, &requested)
+	defer server.Close()
+
+	client, err := zendesk.NewURLClient(server.URL, "", "")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	exporter := &zendesk.Exporter{Client: client, Resource: zendesk.ExportTickets, RequestsPerMinute: 60000}
+	pages, errc := exporter.Stream(ctx)
+
+	<-pages
+	cancel()
+	for range pages {
+	}
+
+	require.ErrorIs(t, <-errc, context.Canceled)
+}
@@ -1,7 +1,7 @@
 package zendesk
 
 import (
-	"fmt"
+	"context"
 	"time"
 )
 
@@ -21,37 +21,69 @@ type Group struct {
 //
 // Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/core/groups#show-group
 func (c *client) ShowGroup(id int64) (*Group, error) {
+	return c.ShowGroupContext(context.Background(), id)
+}
+
+// ShowGroupContext is like ShowGroup but uses ctx for the underlying request.
+func (c *client) ShowGroupContext(ctx context.Context, id int64) (*Group, error) {
 	out := new(APIPayload)
-	err := c.get(fmt.Sprintf("/api/v2/groups/%d.json", id), out)
+	err := c.get(ctx, "ShowGroup", routef("/api/v2/groups/%d.json", id), out)
 	return out.Group, err
 }
 
 // CreateGroup creates a group.
 func (c *client) CreateGroup(group *Group) (*Group, error) {
+	return c.CreateGroupContext(context.Background(), group)
+}
+
+// CreateGroupContext is like CreateGroup but uses ctx for the underlying request.
+func (c *client) CreateGroupContext(ctx context.Context, group *Group) (*Group, error) {
 	in := &APIPayload{Group: group}
 	out := new(APIPayload)
-	err := c.post("/api/v2/groups.json", in, out)
+	err := c.post(ctx, "CreateGroup", routef("/api/v2/groups.json"), in, out)
 	return out.Group, err
 }
 
 // ListGroups lists all groups.
 func (c *client) ListGroups() ([]Group, error) {
+	return c.ListGroupsContext(context.Background())
+}
+
+// ListGroupsContext is like ListGroups but uses ctx for the underlying request.
+func (c *client) ListGroupsContext(ctx context.Context) ([]Group, error) {
 	out := new(APIPayload)
-	err := c.get(fmt.Sprintf("/api/v2/groups.json"), out)
+	err := c.get(ctx, "ListGroups", routef("/api/v2/groups.json"), out)
 
 	return out.Groups, err
 }
 
+// ListGroupsPager is like ListGroups but returns a Pager over all the pages.
+func (c *client) ListGroupsPager(opts *ListOptions) *Pager[Group] {
+	params, err := listParams(opts)
+	rt := routef("/api/v2/groups.json?%s", params.Encode())
+	return newURLPager(c, "ListGroupsPager", rt, err, func(out *APIPayload) []Group { return out.Groups })
+}
+
 // UpdateGroup updates a group.
 func (c *client) UpdateGroup(id int64, group *Group) (*Group, error) {
+	return c.UpdateGroupContext(context.Background(), id, group)
+}
+
+// UpdateGroupContext is like UpdateGroup but uses ctx for the underlying request.
+func (c *client) UpdateGroupContext(ctx context.Context, id int64, group *Group) (*Group, error) {
 	in := &APIPayload{Group: group}
 	out := new(APIPayload)
-	err := c.put(fmt.Sprintf("/api/v2/groups/%d.json", id), in, out)
+	err := c.put(ctx, "UpdateGroup", routef("/api/v2/groups/%d.json", id), in, out)
 	return out.Group, err
 }
 
 // DeleteGroup deletes a group.
 func (c *client) DeleteGroup(id int64) error {
-	err := c.delete(fmt.Sprintf("/api/v2/groups/%d.json", id), nil)
+	return c.DeleteGroupContext(context.Background(), id)
+}
+
+// DeleteGroupContext is like DeleteGroup but uses ctx for the underlying request.
+func (c *client) DeleteGroupContext(ctx context.Context, id int64) error {
+	err := c.delete(ctx, "DeleteGroup", routef("/api/v2/groups/%d.json", id), nil)
 	return err
 }
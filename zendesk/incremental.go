@@ -0,0 +1,112 @@
+package zendesk
+
+import (
+	"context"
+	"net/url"
+)
+
+// IncrementalOptions specifies the parameters of the incremental export methods.
+//
+// Zendesk Core API docs: https://developer.zendesk.com/api-reference/ticketing/ticket-management/incremental_exports/
+type IncrementalOptions struct {
+	// Sets the Unix epoch time to start the export from. It's ignored when Cursor is set.
+	StartTime int64 `url:"start_time"`
+	// Sets the cursor to resume a cursor-based export from, taken from IncrementalExport.AfterCursor.
+	Cursor string `url:"cursor,omitempty"`
+	// Sets the number of records to include per page.
+	PerPage int `url:"per_page,omitempty"`
+}
+
+// IncrementalExport represents a page of records returned by the incremental export methods.
+type IncrementalExport struct {
+	Tickets       []Ticket       `json:"tickets,omitempty"`
+	Users         []User         `json:"users,omitempty"`
+	Groups        []Group        `json:"groups,omitempty"`
+	Organizations []Organization `json:"organizations,omitempty"`
+
+	TicketEvents       []TicketEvent       `json:"ticket_events,omitempty"`
+	TicketMetricEvents []TicketMetricEvent `json:"ticket_metric_events,omitempty"`
+
+	// EndTime is the Unix epoch time of the most recent record of the page.
+	// The next page of a time-based export starts from it.
+	EndTime *int64 `json:"end_time,omitempty"`
+	// AfterCursor is the cursor of the next page of a cursor-based export.
+	AfterCursor *string `json:"after_cursor,omitempty"`
+	AfterURL    *string `json:"after_url,omitempty"`
+	NextPage    *string `json:"next_page,omitempty"`
+	Count       *int64  `json:"count,omitempty"`
+	// EndOfStream reports whether the page is the last one currently available.
+	EndOfStream bool `json:"end_of_stream"`
+}
+
+// IncrementalTickets returns the tickets that changed since the given start time
+// or cursor, using the cursor-based incremental export.
+//
+// Zendesk Core API docs: https://developer.zendesk.com/api-reference/ticketing/ticket-management/incremental_exports/#incremental-ticket-export-cursor-based
+func (c *client) IncrementalTickets(options *IncrementalOptions, sideloads ...SideLoad) (*IncrementalExport, error) {
+	return c.IncrementalTicketsContext(context.Background(), options, sideloads...)
+}
+
+// IncrementalTicketsContext is like IncrementalTickets but uses ctx for the underlying request.
+func (c *client) IncrementalTicketsContext(ctx context.Context, options *IncrementalOptions, sideloads ...SideLoad) (*IncrementalExport, error) {
+	return c.incrementalExport(ctx, "IncrementalTickets", "/api/v2/incremental/tickets/cursor.json", options, sideloads...)
+}
+
+// IncrementalUsers returns the users that changed since the given start time
+// or cursor, using the cursor-based incremental export.
+//
+// Zendesk Core API docs: https://developer.zendesk.com/api-reference/ticketing/ticket-management/incremental_exports/#incremental-user-export-cursor-based
+func (c *client) IncrementalUsers(options *IncrementalOptions, sideloads ...SideLoad) (*IncrementalExport, error) {
+	return c.IncrementalUsersContext(context.Background(), options, sideloads...)
+}
+
+// IncrementalUsersContext is like IncrementalUsers but uses ctx for the underlying request.
+func (c *client) IncrementalUsersContext(ctx context.Context, options *IncrementalOptions, sideloads ...SideLoad) (*IncrementalExport, error) {
+	return c.incrementalExport(ctx, "IncrementalUsers", "/api/v2/incremental/users/cursor.json", options, sideloads...)
+}
+
+// IncrementalOrganizations returns the organizations that changed since the given
+// start time, using the time-based incremental export. The next page starts from
+// the EndTime of the previous one.
+//
+// Zendesk Core API docs: https://developer.zendesk.com/api-reference/ticketing/ticket-management/incremental_exports/#incremental-organization-export
+func (c *client) IncrementalOrganizations(options *IncrementalOptions, sideloads ...SideLoad) (*IncrementalExport, error) {
+	return c.IncrementalOrganizationsContext(context.Background(), options, sideloads...)
+}
+
+// IncrementalOrganizationsContext is like IncrementalOrganizations but uses ctx for the underlying request.
+func (c *client) IncrementalOrganizationsContext(ctx context.Context, options *IncrementalOptions, sideloads ...SideLoad) (*IncrementalExport, error) {
+	return c.incrementalExport(ctx, "IncrementalOrganizations", "/api/v2/incremental/organizations.json", options, sideloads...)
+}
+
+func (c *client) incrementalExport(ctx context.Context, op, endpoint string, options *IncrementalOptions, sideloads ...SideLoad) (*IncrementalExport, error) {
+	params, err := incrementalParams(options, sideloads...)
+	if err != nil {
+		return nil, err
+	}
+
+	out := new(IncrementalExport)
+	err = c.get(ctx, op, routef(endpoint+"?%s", params.Encode()), out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// incrementalParams encodes the options and side-loads of an incremental export
+// request. The start time is only sent when there is no cursor to resume from.
+func incrementalParams(options *IncrementalOptions, sideloads ...SideLoad) (url.Values, error) {
+	if options == nil {
+		options = &IncrementalOptions{}
+	}
+
+	params, err := listParams(options, sideloads...)
+	if err != nil {
+		return nil, err
+	}
+
+	if options.Cursor != "" {
+		params.Del("start_time")
+	}
+	return params, nil
+}
@@ -0,0 +1,65 @@
+package zendesk_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/MEDIGO/go-zendesk/zendesk"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIncrementalTickets(t *testing.T) {
+	var requested []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = append(requested, r.URL.RequestURI())
+
+		if r.URL.Query().Get("cursor") == "" {
+			fmt.Fprint(w, `{"tickets":[{"id":1,"requester_id":3}],"users":[{"id":3}],"after_cursor":"abc","end_time":1600000000,"end_of_stream":false}`)
+			return
+		}
+		fmt.Fprint(w, `{"tickets":[{"id":2}],"after_cursor":"def","end_time":1600000100,"end_of_stream":true}`)
+	}))
+	defer server.Close()
+
+	client, err := zendesk.NewURLClient(server.URL, "", "")
+	require.NoError(t, err)
+
+	page, err := client.IncrementalTickets(&zendesk.IncrementalOptions{StartTime: 1500000000}, zendesk.IncludeUsers())
+	require.NoError(t, err)
+	require.Len(t, page.Tickets, 1)
+	require.Len(t, page.Users, 1)
+	require.Equal(t, "abc", *page.AfterCursor)
+	require.Equal(t, int64(1600000000), *page.EndTime)
+	require.False(t, page.EndOfStream)
+
+	page, err = client.IncrementalTickets(&zendesk.IncrementalOptions{StartTime: 1500000000, Cursor: *page.AfterCursor}, zendesk.IncludeUsers())
+	require.NoError(t, err)
+	require.Len(t, page.Tickets, 1)
+	require.True(t, page.EndOfStream)
+
+	require.Equal(t, []string{
+		"/api/v2/incremental/tickets/cursor.json?include=users&start_time=1500000000",
+		"/api/v2/incremental/tickets/cursor.json?cursor=abc&include=users",
+	}, requested)
+}
+
+func TestIncrementalOrganizations(t *testing.T) {
+	var requested string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = r.URL.RequestURI()
+		fmt.Fprint(w, `{"organizations":[{"id":1},{"id":2}],"count":2,"end_time":1600000000,"next_page":null,"end_of_stream":true}`)
+	}))
+	defer server.Close()
+
+	client, err := zendesk.NewURLClient(server.URL, "", "")
+	require.NoError(t, err)
+
+	page, err := client.IncrementalOrganizations(&zendesk.IncrementalOptions{StartTime: 0, PerPage: 100})
+	require.NoError(t, err)
+	require.Len(t, page.Organizations, 2)
+	require.Equal(t, int64(2), *page.Count)
+	require.True(t, page.EndOfStream)
+	require.Equal(t, "/api/v2/incremental/organizations.json?per_page=100&start_time=0", requested)
+}
@@ -1,37 +1,208 @@
 package zendesk
 
-import "fmt"
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ErrJobFailed is returned by WaitForJob when the job failed or was killed.
+var ErrJobFailed = errors.New("zendesk: job failed")
+
+const (
+	// defaultJobPollInterval is the first poll interval of WaitForJob when none is given.
+	defaultJobPollInterval = time.Second
+	// maxJobPollInterval caps the backoff between the polls of WaitForJob.
+	maxJobPollInterval = 30 * time.Second
+	// defaultJobTimeout bounds WaitForJob when ctx has no deadline.
+	defaultJobTimeout = 10 * time.Minute
+)
 
 // JobStatus represents a Zendesk JobStatus.
 //
 // Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/core/job_statuses#json-format
 type JobStatus struct {
-	ID       *string `json:"id,omitempty"`
-	Message  *string `json:"message,omitempty"`
-	Progress *int64  `json:"progress,omitempty"`
-	// TODO: Raise this issue with ZenDesk support
-	// API docs are inconsistent with actual behaviour
-	Results *Result `json:"results,omitempty"`
-	Status  *string `json:"status,omitempty"`
-	Total   *int64  `json:"total,omitempty"`
-	URL     *string `json:"url,omitempty"`
+	ID       *string    `json:"id,omitempty"`
+	Message  *string    `json:"message,omitempty"`
+	Progress *int64     `json:"progress,omitempty"`
+	Results  JobResults `json:"results,omitempty"`
+	Status   *string    `json:"status,omitempty"`
+	Total    *int64     `json:"total,omitempty"`
+	URL      *string    `json:"url,omitempty"`
+}
+
+// Done reports whether the job stopped running, whether it succeeded or not.
+func (s *JobStatus) Done() bool {
+	if s.Status == nil {
+		return false
+	}
+	switch *s.Status {
+	case "completed", "failed", "killed":
+		return true
+	}
+	return false
 }
 
 // Result represents the data from processed tasks within the Job Status
 type Result struct {
-	Action  *string `json:"action,omitempty"`
-	Errors  *string `json:"errors,omitempty"`
-	ID      *int64  `json:"id,omitempty"`
-	Status  *string `json:"status,omitempty"`
-	Success *bool   `json:"success,omitempty"`
-	Title   *string `json:"title,omitempty"`
+	Action     *string `json:"action,omitempty"`
+	Errors     *string `json:"errors,omitempty"`
+	Error      *string `json:"error,omitempty"`
+	Details    *string `json:"details,omitempty"`
+	ID         *int64  `json:"id,omitempty"`
+	ExternalID *string `json:"external_id,omitempty"`
+	Index      *int64  `json:"index,omitempty"`
+	Status     *string `json:"status,omitempty"`
+	Success    *bool   `json:"success,omitempty"`
+	Title      *string `json:"title,omitempty"`
+}
+
+// Failed reports whether the task of the result failed.
+func (r *Result) Failed() bool {
+	return r.Error != nil || r.Errors != nil || (r.Success != nil && !*r.Success)
+}
+
+// JobResults holds the results of the tasks of a job. The API docs describe
+// the results as an array, but some jobs report a single object, which is
+// decoded as a one-element slice.
+type JobResults []Result
+
+// UnmarshalJSON accepts both an array of results and a single result.
+func (r *JobResults) UnmarshalJSON(data []byte) error {
+	if trimmed := strings.TrimSpace(string(data)); trimmed == "null" {
+		*r = nil
+		return nil
+	} else if !strings.HasPrefix(trimmed, "[") {
+		var result Result
+		if err := json.Unmarshal(data, &result); err != nil {
+			return err
+		}
+		*r = JobResults{result}
+		return nil
+	}
+
+	var results []Result
+	if err := json.Unmarshal(data, &results); err != nil {
+		return err
+	}
+	*r = results
+	return nil
+}
+
+// Failed returns the results of the tasks that failed.
+func (r JobResults) Failed() []Result {
+	var failed []Result
+	for _, result := range r {
+		if result.Failed() {
+			failed = append(failed, result)
+		}
+	}
+	return failed
 }
 
 // Show Job Status shows the status of a background job
 //
 // Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/core/job_statuses#show-job-status
 func (c *client) ShowJobStatus(id string) (*JobStatus, error) {
+	return c.ShowJobStatusContext(context.Background(), id)
+}
+
+// ShowJobStatusContext is like ShowJobStatus but uses ctx for the underlying request.
+func (c *client) ShowJobStatusContext(ctx context.Context, id string) (*JobStatus, error) {
 	out := new(APIPayload)
-	err := c.get(fmt.Sprintf("/api/v2/job_statuses/%s.json", id), out)
+	err := c.get(ctx, "ShowJobStatus", routef("/api/v2/job_statuses/%s.json", id), out)
 	return out.JobStatus, err
 }
+
+// ShowManyJobStatuses shows the statuses of many background jobs. Like
+// ShowManyUsers, the ids are sent in chunks of 100 and the statuses are
+// returned in the order of the ids.
+//
+// Zendesk Core API docs: https://developer.zendesk.com/api-reference/ticketing/ticket-management/job_statuses/#show-many-job-statuses
+func (c *client) ShowManyJobStatuses(ids []string) ([]JobStatus, error) {
+	return c.ShowManyJobStatusesContext(context.Background(), ids)
+}
+
+// ShowManyJobStatusesContext is like ShowManyJobStatuses but uses ctx for the underlying request.
+func (c *client) ShowManyJobStatusesContext(ctx context.Context, ids []string) ([]JobStatus, error) {
+	statuses, err := chunked(ctx, c, ids, func(ctx context.Context, ids []string) ([]JobStatus, error) {
+		out := new(APIPayload)
+		err := c.get(ctx, "ShowManyJobStatuses", routef("/api/v2/job_statuses/show_many.json?ids=%s", strings.Join(ids, ",")), out)
+		return out.JobStatuses, err
+	})
+
+	sortByInput(statuses, ids, func(status *JobStatus) (string, bool) {
+		if status.ID == nil {
+			return "", false
+		}
+		return *status.ID, true
+	})
+	return statuses, err
+}
+
+// ListJobStatuses lists the statuses of the recent background jobs.
+//
+// Zendesk Core API docs: https://developer.zendesk.com/api-reference/ticketing/ticket-management/job_statuses/#list-job-statuses
+func (c *client) ListJobStatuses() ([]JobStatus, error) {
+	return c.ListJobStatusesContext(context.Background())
+}
+
+// ListJobStatusesContext is like ListJobStatuses but uses ctx for the underlying request.
+func (c *client) ListJobStatusesContext(ctx context.Context) ([]JobStatus, error) {
+	out := new(APIPayload)
+	err := c.get(ctx, "ListJobStatuses", routef("/api/v2/job_statuses.json"), out)
+	return out.JobStatuses, err
+}
+
+// WaitForJob polls the status of a background job until it stops running. The
+// interval between the polls starts at pollInterval, or one second if it's zero,
+// and backs off up to 30 seconds. When ctx has no deadline, WaitForJob gives up
+// after 10 minutes.
+//
+// The last status fetched is returned along with any error, which is ErrJobFailed
+// when the job failed or was killed. The failures of the individual tasks of a
+// completed job are reported by JobStatus.Results instead.
+func (c *client) WaitForJob(ctx context.Context, id string, pollInterval time.Duration) (*JobStatus, error) {
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, defaultJobTimeout)
+		defer cancel()
+	}
+
+	interval := pollInterval
+	if interval <= 0 {
+		interval = defaultJobPollInterval
+	}
+
+	var last *JobStatus
+	for {
+		status, err := c.ShowJobStatusContext(ctx, id)
+		if err != nil {
+			return last, err
+		}
+		last = status
+
+		if status != nil && status.Done() {
+			if *status.Status != "completed" {
+				msg := *status.Status
+				if status.Message != nil {
+					msg += ": " + *status.Message
+				}
+				return status, fmt.Errorf("%w: %s", ErrJobFailed, msg)
+			}
+			return status, nil
+		}
+
+		if err := sleep(ctx, interval); err != nil {
+			return status, err
+		}
+
+		interval = interval * 3 / 2
+		if interval > maxJobPollInterval {
+			interval = maxJobPollInterval
+		}
+	}
+}
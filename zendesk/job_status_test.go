@@ -0,0 +1,113 @@
+package zendesk_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/MEDIGO/go-zendesk/zendesk"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobResultsUnmarshal(t *testing.T) {
+	var status zendesk.JobStatus
+	require.NoError(t, json.Unmarshal([]byte(`{"id":"a","status":"completed","results":[
+		{"id":1,"action":"update","success":true,"status":"Updated"},
+		{"index":1,"error":"TicketUpdateFailed","details":"Subject: cannot be blank"}
+	]}`), &status))
+	require.Len(t, status.Results, 2)
+
+	failed := status.Results.Failed()
+	require.Len(t, failed, 1)
+	require.Equal(t, int64(1), *failed[0].Index)
+	require.Equal(t, "TicketUpdateFailed", *failed[0].Error)
+
+	// Some jobs report a single result object.
+	require.NoError(t, json.Unmarshal([]byte(`{"id":"b","results":{"id":2,"success":false,"errors":"nope"}}`), &status))
+	require.Len(t, status.Results, 1)
+	require.True(t, status.Results[0].Failed())
+
+	require.NoError(t, json.Unmarshal([]byte(`{"id":"c","results":null}`), &status))
+	require.Nil(t, status.Results)
+}
+
+func TestWaitForJob(t *testing.T) {
+	var polls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		status := "working"
+		if polls == 3 {
+			status = "completed"
+		}
+		fmt.Fprintf(w, `{"job_status":{"id":"abc","status":"%s","results":[{"id":1,"success":true}]}}`, status)
+	}))
+	defer server.Close()
+
+	client, err := zendesk.NewURLClient(server.URL, "", "")
+	require.NoError(t, err)
+
+	status, err := client.WaitForJob(context.Background(), "abc", time.Millisecond)
+	require.NoError(t, err)
+	require.Equal(t, "completed", *status.Status)
+	require.Equal(t, 3, polls)
+}
+
+func TestWaitForJobFailed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"job_status":{"id":"abc","status":"failed","message":"boom"}}`)
+	}))
+	defer server.Close()
+
+	client, err := zendesk.NewURLClient(server.URL, "", "")
+	require.NoError(t, err)
+
+	status, err := client.WaitForJob(context.Background(), "abc", time.Millisecond)
+	require.True(t, errors.Is(err, zendesk.ErrJobFailed))
+	require.Equal(t, "failed", *status.Status)
+}
+
+func TestWaitForJobTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"job_status":{"id":"abc","status":"queued"}}`)
+	}))
+	defer server.Close()
+
+	client, err := zendesk.NewURLClient(server.URL, "", "")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	status, err := client.WaitForJob(ctx, "abc", 10*time.Millisecond)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Equal(t, "queued", *status.Status)
+}
+
+func TestShowManyJobStatuses(t *testing.T) {
+	var requested []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = append(requested, r.URL.RequestURI())
+		fmt.Fprint(w, `{"job_statuses":[{"id":"b","status":"queued"},{"id":"a","status":"completed"}]}`)
+	}))
+	defer server.Close()
+
+	client, err := zendesk.NewURLClient(server.URL, "", "")
+	require.NoError(t, err)
+
+	statuses, err := client.ShowManyJobStatuses([]string{"a", "b"})
+	require.NoError(t, err)
+	require.Len(t, statuses, 2)
+	require.Equal(t, "a", *statuses[0].ID)
+	require.Equal(t, "b", *statuses[1].ID)
+
+	statuses, err = client.ListJobStatuses()
+	require.NoError(t, err)
+	require.Len(t, statuses, 2)
+
+	require.Equal(t, []string{"/api/v2/job_statuses/show_many.json?ids=a,b", "/api/v2/job_statuses.json"}, requested)
+}
@@ -1,7 +1,7 @@
 package zendesk
 
 import (
-	"fmt"
+	"context"
 	"time"
 )
 
@@ -18,19 +18,34 @@ type Locale struct {
 }
 
 func (c *client) ListLocales() ([]Locale, error) {
+	return c.ListLocalesContext(context.Background())
+}
+
+// ListLocalesContext is like ListLocales but uses ctx for the underlying request.
+func (c *client) ListLocalesContext(ctx context.Context) ([]Locale, error) {
 	out := new(APIPayload)
-	err := c.get("/api/v2/locales.json", out)
+	err := c.get(ctx, "ListLocales", routef("/api/v2/locales.json"), out)
 	return out.Locales, err
 }
 
 func (c *client) ShowLocale(id int64) (*Locale, error) {
+	return c.ShowLocaleContext(context.Background(), id)
+}
+
+// ShowLocaleContext is like ShowLocale but uses ctx for the underlying request.
+func (c *client) ShowLocaleContext(ctx context.Context, id int64) (*Locale, error) {
 	out := new(APIPayload)
-	err := c.get(fmt.Sprintf("/api/v2/locales/%d.json", id), out)
+	err := c.get(ctx, "ShowLocale", routef("/api/v2/locales/%d.json", id), out)
 	return out.Locale, err
 }
 
 func (c *client) ShowLocaleByCode(code string) (*Locale, error) {
+	return c.ShowLocaleByCodeContext(context.Background(), code)
+}
+
+// ShowLocaleByCodeContext is like ShowLocaleByCode but uses ctx for the underlying request.
+func (c *client) ShowLocaleByCodeContext(ctx context.Context, code string) (*Locale, error) {
 	out := new(APIPayload)
-	err := c.get(fmt.Sprintf("/api/v2/locales/%s.json", code), out)
+	err := c.get(ctx, "ShowLocaleByCode", routef("/api/v2/locales/%s.json", code), out)
 	return out.Locale, err
 }
@@ -0,0 +1,191 @@
+package zendesk
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// DefaultRedactFields lists the JSON fields scrubbed from logged bodies by default.
+var DefaultRedactFields = []string{
+	"email", "phone", "name", "password", "token", "full_token",
+	"access_token", "refresh_token", "client_secret", "secret",
+}
+
+const redacted = "[REDACTED]"
+
+// LogOptions configures the logging of API traffic.
+type LogOptions struct {
+	// Bodies enables logging of the request and response bodies, along with
+	// the request headers. They are only logged when debug level is enabled.
+	Bodies bool
+	// RedactFields lists the JSON fields whose values are scrubbed from logged bodies.
+	RedactFields []string
+}
+
+// LogOption configures WithLogger.
+type LogOption func(*LogOptions)
+
+// LogBodies enables the debug logging of redacted request and response bodies.
+func LogBodies() LogOption {
+	return func(o *LogOptions) {
+		o.Bodies = true
+	}
+}
+
+// RedactFields scrubs the values of the given JSON fields from logged bodies, in
+// addition to DefaultRedactFields.
+func RedactFields(fields ...string) LogOption {
+	return func(o *LogOptions) {
+		o.RedactFields = append(o.RedactFields, fields...)
+	}
+}
+
+// WithLogger logs every request sent by the client to logger, with its method,
+// path, status, duration, attempt number and Zendesk request ID.
+func WithLogger(logger *slog.Logger, opts ...LogOption) ClientOption {
+	options := &LogOptions{RedactFields: append([]string(nil), DefaultRedactFields...)}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	return WithMiddleware(loggingMiddleware(logger, options))
+}
+
+func loggingMiddleware(logger *slog.Logger, options *LogOptions) Middleware {
+	redact := make(map[string]bool, len(options.RedactFields))
+	for _, field := range options.RedactFields {
+		redact[strings.ToLower(field)] = true
+	}
+
+	return func(next Handler) Handler {
+		return func(call *Call) (*http.Response, error) {
+			ctx := call.Request.Context()
+			bodies := options.Bodies && logger.Enabled(ctx, slog.LevelDebug)
+
+			attrs := []slog.Attr{
+				slog.String("method", call.Request.Method),
+				slog.String("path", call.Request.URL.Path),
+				slog.Int("attempt", call.Attempt),
+			}
+
+			if bodies {
+				attrs = append(attrs, slog.Any("request_headers", redactHeaders(call.Request.Header)))
+				if call.Request.GetBody != nil {
+					if body, err := call.Request.GetBody(); err == nil {
+						attrs = append(attrs, slog.String("request_body", redactBody(body, redact)))
+					}
+				}
+			}
+
+			start := time.Now()
+			res, err := next(call)
+			attrs = append(attrs, slog.Duration("duration", time.Since(start)))
+
+			if res != nil {
+				attrs = append(attrs,
+					slog.Int("status", res.StatusCode),
+					slog.String("request_id", res.Header.Get("X-Request-Id")),
+				)
+
+				if bodies {
+					buf, readErr := io.ReadAll(res.Body)
+					res.Body.Close()
+					res.Body = io.NopCloser(bytes.NewReader(buf))
+					if readErr == nil {
+						attrs = append(attrs, slog.String("response_body", redactBody(bytes.NewReader(buf), redact)))
+					}
+				}
+			}
+
+			level := slog.LevelInfo
+			if err != nil {
+				level = slog.LevelWarn
+				attrs = append(attrs, slog.String("error", redactError(err, redact)))
+			}
+
+			logger.LogAttrs(ctx, level, "zendesk request", attrs...)
+
+			return res, err
+		}
+	}
+}
+
+// redactHeaders returns a copy of h with the credentials scrubbed.
+func redactHeaders(h http.Header) http.Header {
+	out := h.Clone()
+	if out.Get("Authorization") != "" {
+		out.Set("Authorization", redacted)
+	}
+	return out
+}
+
+// redactError returns the text of err. The details of API errors reported for
+// the fields in redact are scrubbed, as they often quote the invalid values.
+func redactError(err error, redact map[string]bool) string {
+	var apierr *APIError
+	if !errors.As(err, &apierr) || apierr.Details == nil {
+		return err.Error()
+	}
+
+	details := make(map[string][]*APIErrorDetail, len(*apierr.Details))
+	for field, fieldErrs := range *apierr.Details {
+		if redact[strings.ToLower(field)] {
+			scrubbed := make([]*APIErrorDetail, len(fieldErrs))
+			for i, fieldErr := range fieldErrs {
+				scrubbed[i] = &APIErrorDetail{Type: fieldErr.Type, Description: String(redacted)}
+			}
+			fieldErrs = scrubbed
+		}
+		details[field] = fieldErrs
+	}
+
+	out := *apierr
+	out.Details = &details
+	// The API error may be wrapped, so replace its text within the text of err.
+	text := err.Error()
+	if !strings.Contains(text, apierr.Error()) {
+		return out.Error()
+	}
+	return strings.Replace(text, apierr.Error(), out.Error(), 1)
+}
+
+// redactBody reads a JSON body and scrubs the values of the fields in redact.
+func redactBody(body io.Reader, redact map[string]bool) string {
+	var v interface{}
+	if err := json.NewDecoder(body).Decode(&v); err != nil {
+		if errors.Is(err, io.EOF) {
+			return ""
+		}
+		return "<non-JSON body>"
+	}
+
+	out, err := json.Marshal(redactValue(v, redact))
+	if err != nil {
+		return "<non-JSON body>"
+	}
+	return string(out)
+}
+
+func redactValue(v interface{}, redact map[string]bool) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if redact[strings.ToLower(key)] {
+				v[key] = redacted
+			} else {
+				v[key] = redactValue(value, redact)
+			}
+		}
+	case []interface{}:
+		for i, value := range v {
+			v[i] = redactValue(value, redact)
+		}
+	}
+	return v
+}
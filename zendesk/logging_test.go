@@ -0,0 +1,141 @@
+package zendesk_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/MEDIGO/go-zendesk/zendesk"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithLogger(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req-1")
+		w.Write([]byte(`{"user":{"id":1,"name":"Jane","email":"jane@example.com","role":"end-user"}}`))
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(handler))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	client, err := zendesk.NewURLClient(server.URL, "user", "password",
+		zendesk.WithLogger(logger, zendesk.LogBodies(), zendesk.RedactFields("role")))
+	require.NoError(t, err)
+
+	user, err := client.CreateUser(&zendesk.User{Email: zendesk.String("jane@example.com"), Notes: zendesk.String("vip")})
+	require.NoError(t, err)
+	require.Equal(t, "jane@example.com", *user.Email, "expected the response to be left untouched")
+
+	var record map[string]interface{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+
+	require.Equal(t, "zendesk request", record["msg"])
+	require.Equal(t, "POST", record["method"])
+	require.Equal(t, "/api/v2/users.json", record["path"])
+	require.Equal(t, float64(200), record["status"])
+	require.Equal(t, float64(1), record["attempt"])
+	require.Equal(t, "req-1", record["request_id"])
+	require.Contains(t, record, "duration")
+
+	require.JSONEq(t, `{"attachment":null,"attachments":null,"user":{"email":"[REDACTED]","notes":"vip"}}`, record["request_body"].(string))
+	require.JSONEq(t, `{"user":{"id":1,"name":"[REDACTED]","email":"[REDACTED]","role":"[REDACTED]"}}`, record["response_body"].(string))
+
+	headers := record["request_headers"].(map[string]interface{})
+	require.Equal(t, []interface{}{"[REDACTED]"}, headers["Authorization"])
+}
+
+func TestWithLoggerWithoutBodies(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		http.NotFound(w, r)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(handler))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	client, err := zendesk.NewURLClient(server.URL, "", "", zendesk.WithLogger(logger))
+	require.NoError(t, err)
+
+	_, err = client.ShowUser(1)
+	require.Error(t, err)
+
+	var record map[string]interface{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+	require.Equal(t, "WARN", record["level"])
+	require.Equal(t, float64(404), record["status"])
+	require.Contains(t, record, "error")
+	require.NotContains(t, record, "request_body")
+	require.NotContains(t, record, "response_body")
+}
+
+func TestWithLoggerRedactsErrors(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		w.Write([]byte(`{"error":"RecordInvalid","description":"Record validation errors","details":{"email":[{"error":"DuplicateValue","description":"Email: jane@example.com is already being used by another user"}]}}`))
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(handler))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	client, err := zendesk.NewURLClient(server.URL, "", "", zendesk.WithLogger(logger, zendesk.LogBodies()))
+	require.NoError(t, err)
+
+	_, err = client.CreateUser(&zendesk.User{Email: zendesk.String("jane@example.com")})
+	require.True(t, zendesk.IsValidation(err))
+	require.Contains(t, err.Error(), "jane@example.com", "expected the returned error to be left untouched")
+
+	var record map[string]interface{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+	require.Equal(t, "WARN", record["level"])
+	require.NotContains(t, record["error"], "jane@example.com")
+	require.Contains(t, record["error"], "RecordInvalid")
+	require.JSONEq(t, `{"error":"RecordInvalid","description":"Record validation errors","details":{"email":"[REDACTED]"}}`, record["response_body"].(string))
+}
+
+func TestWithLoggerRedactsWrappedErrors(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		w.Write([]byte(`{"error":"RecordInvalid","details":{"email":[{"error":"DuplicateValue","description":"Email: jane@example.com is already being used by another user"}]}}`))
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(handler))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	// the middleware registered after the logger runs inside it and wraps its errors
+	wrap := func(next zendesk.Handler) zendesk.Handler {
+		return func(call *zendesk.Call) (*http.Response, error) {
+			res, err := next(call)
+			if err != nil {
+				err = fmt.Errorf("wrapped: %w", err)
+			}
+			return res, err
+		}
+	}
+
+	client, err := zendesk.NewURLClient(server.URL, "", "", zendesk.WithLogger(logger), zendesk.WithMiddleware(wrap))
+	require.NoError(t, err)
+
+	_, err = client.CreateUser(&zendesk.User{Email: zendesk.String("jane@example.com")})
+	require.True(t, zendesk.IsValidation(err))
+
+	var record map[string]interface{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+	require.Contains(t, record["error"], "wrapped: ")
+	require.Contains(t, record["error"], "RecordInvalid")
+	require.NotContains(t, record["error"], "jane@example.com")
+}
@@ -0,0 +1,78 @@
+package zendesk
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+)
+
+// Call describes a single attempt at an API call.
+type Call struct {
+	// Request is the HTTP request sent to Zendesk. Its URL is resolved against
+	// the base URL of the client.
+	Request *http.Request
+	// Endpoint is the API endpoint requested by the client method, e.g. /api/v2/tickets/1.json.
+	Endpoint string
+	// Template is the path of the endpoint with its parameters replaced by
+	// placeholders, e.g. /api/v2/tickets/{id}.json.
+	Template string
+	// Operation is the name of the Client method that made the call, e.g. ShowTicket.
+	// Context-aware variants are reported under the name of their counterpart.
+	Operation string
+	// Attempt is the number of the attempt, starting at 1 and increased by each retry.
+	Attempt int
+}
+
+// Handler sends the request of a call. Responses with a status code outside
+// the 2xx range are returned along with the decoded *APIError, and their body
+// can still be read.
+type Handler func(call *Call) (*http.Response, error)
+
+// Middleware wraps a Handler to act on the requests and responses passing through it.
+type Middleware func(next Handler) Handler
+
+// WithMiddleware adds middlewares to the request pipeline of the client. Middlewares
+// are called in the order they are added, the first one being the outermost, and
+// run once per attempt when requests are retried.
+func WithMiddleware(middlewares ...Middleware) ClientOption {
+	return func(c *client) {
+		c.middlewares = append(c.middlewares[:len(c.middlewares):len(c.middlewares)], middlewares...)
+	}
+}
+
+// handle sends the request of call through the middlewares of the client.
+func (c *client) handle(call *Call) (*http.Response, error) {
+	h := Handler(c.roundTrip)
+	for i := len(c.middlewares) - 1; i >= 0; i-- {
+		h = c.middlewares[i](h)
+	}
+	return h(call)
+}
+
+// roundTrip sends the request of call and decodes the error responses.
+func (c *client) roundTrip(call *Call) (*http.Response, error) {
+	res, err := c.client.Do(call.Request)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.limiter != nil {
+		c.limiter.update(res)
+	}
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		// Keep the body readable for the middlewares, e.g. to log it.
+		buf, err := io.ReadAll(res.Body)
+		res.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		res.Body = io.NopCloser(bytes.NewReader(buf))
+		apierr := decodeAPIError(res)
+		res.Body = io.NopCloser(bytes.NewReader(buf))
+		return res, apierr
+	}
+
+	return res, nil
+}
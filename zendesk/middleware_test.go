@@ -0,0 +1,81 @@
+package zendesk_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/MEDIGO/go-zendesk/zendesk"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMiddlewareChain(t *testing.T) {
+	attempts := 0
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "value", r.Header.Get("X-Injected"))
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte(`{"error":"ServiceUnavailable"}`))
+			return
+		}
+		w.Write([]byte(`{"locale":{"id":1}}`))
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(handler))
+	defer server.Close()
+
+	var trace []string
+	var apiErrors []*zendesk.APIError
+
+	record := func(name string) zendesk.Middleware {
+		return func(next zendesk.Handler) zendesk.Handler {
+			return func(call *zendesk.Call) (*http.Response, error) {
+				trace = append(trace, name+" before")
+				res, err := next(call)
+				trace = append(trace, name+" after")
+				return res, err
+			}
+		}
+	}
+
+	inspect := func(next zendesk.Handler) zendesk.Handler {
+		return func(call *zendesk.Call) (*http.Response, error) {
+			require.Equal(t, "/api/v2/locales/1.json", call.Endpoint)
+			require.Equal(t, "/api/v2/locales/{id}.json", call.Template)
+			require.Equal(t, "ShowLocale", call.Operation)
+			require.Equal(t, server.URL+"/api/v2/locales/1.json", call.Request.URL.String())
+			require.Equal(t, len(apiErrors)+1, call.Attempt)
+
+			call.Request.Header.Set("X-Injected", "value")
+
+			res, err := next(call)
+			var apierr *zendesk.APIError
+			if errors.As(err, &apierr) {
+				apiErrors = append(apiErrors, apierr)
+			}
+			return res, err
+		}
+	}
+
+	client, err := zendesk.NewURLClient(server.URL, "", "",
+		zendesk.WithRetryPolicy(zendesk.RetryPolicy{InitialBackoff: time.Millisecond}),
+		zendesk.WithMiddleware(record("first"), inspect),
+		zendesk.WithMiddleware(record("second")),
+	)
+	require.NoError(t, err)
+
+	locale, err := client.ShowLocale(1)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), *locale.ID)
+
+	require.Len(t, apiErrors, 1)
+	require.Equal(t, "ServiceUnavailable", *apiErrors[0].Type)
+	require.Equal(t, []string{
+		"first before", "second before", "second after", "first after",
+		"first before", "second before", "second after", "first after",
+	}, trace)
+}
@@ -2,7 +2,10 @@ package zendesk
 
 // Code generated by mockery v1.0.0. DO NOT EDIT.
 
+import context "context"
 import io "io"
+import iter "iter"
+import time "time"
 import mock "github.com/stretchr/testify/mock"
 
 // MockClient is an autogenerated mock type for the Client type
@@ -10,6 +13,98 @@ type MockClient struct {
 	mock.Mock
 }
 
+// AddOrganizationTags provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) AddOrganizationTags(_a0 int64, _a1 []string) ([]string, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(int64, []string) []string); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, []string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AddOrganizationTagsContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockClient) AddOrganizationTagsContext(_a0 context.Context, _a1 int64, _a2 []string) ([]string, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []string) []string); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, []string) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AddTicketTags provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) AddTicketTags(_a0 int64, _a1 []string) ([]string, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(int64, []string) []string); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, []string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AddTicketTagsContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockClient) AddTicketTagsContext(_a0 context.Context, _a1 int64, _a2 []string) ([]string, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []string) []string); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, []string) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // AddUserTags provides a mock function with given fields: _a0, _a1
 func (_m *MockClient) AddUserTags(_a0 int64, _a1 []string) ([]string, error) {
 	ret := _m.Called(_a0, _a1)
@@ -33,6 +128,29 @@ func (_m *MockClient) AddUserTags(_a0 int64, _a1 []string) ([]string, error) {
 	return r0, r1
 }
 
+// AddUserTagsContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockClient) AddUserTagsContext(_a0 context.Context, _a1 int64, _a2 []string) ([]string, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []string) []string); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, []string) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // AutocompleteOrganizations provides a mock function with given fields: _a0
 func (_m *MockClient) AutocompleteOrganizations(_a0 string) ([]Organization, error) {
 	ret := _m.Called(_a0)
@@ -56,6 +174,75 @@ func (_m *MockClient) AutocompleteOrganizations(_a0 string) ([]Organization, err
 	return r0, r1
 }
 
+// AutocompleteOrganizationsContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) AutocompleteOrganizationsContext(_a0 context.Context, _a1 string) ([]Organization, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []Organization
+	if rf, ok := ret.Get(0).(func(context.Context, string) []Organization); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Organization)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AutocompleteTags provides a mock function with given fields: _a0
+func (_m *MockClient) AutocompleteTags(_a0 string) ([]string, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(string) []string); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AutocompleteTagsContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) AutocompleteTagsContext(_a0 context.Context, _a1 string) ([]string, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(context.Context, string) []string); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // BatchUpdateManyTickets provides a mock function with given fields: _a0
 func (_m *MockClient) BatchUpdateManyTickets(_a0 []Ticket) error {
 	ret := _m.Called(_a0)
@@ -70,12 +257,12 @@ func (_m *MockClient) BatchUpdateManyTickets(_a0 []Ticket) error {
 	return r0
 }
 
-// BulkUpdateManyTickets provides a mock function with given fields: _a0, _a1
-func (_m *MockClient) BulkUpdateManyTickets(_a0 []int64, _a1 *Ticket) error {
+// BatchUpdateManyTicketsContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) BatchUpdateManyTicketsContext(_a0 context.Context, _a1 []Ticket) error {
 	ret := _m.Called(_a0, _a1)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func([]int64, *Ticket) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, []Ticket) error); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		r0 = ret.Error(0)
@@ -84,21 +271,21 @@ func (_m *MockClient) BulkUpdateManyTickets(_a0 []int64, _a1 *Ticket) error {
 	return r0
 }
 
-// CreateIdentity provides a mock function with given fields: _a0, _a1
-func (_m *MockClient) CreateIdentity(_a0 int64, _a1 *UserIdentity) (*UserIdentity, error) {
+// BulkUpdateManyTickets provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) BulkUpdateManyTickets(_a0 []int64, _a1 *Ticket) ([]JobStatus, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *UserIdentity
-	if rf, ok := ret.Get(0).(func(int64, *UserIdentity) *UserIdentity); ok {
+	var r0 []JobStatus
+	if rf, ok := ret.Get(0).(func([]int64, *Ticket) []JobStatus); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*UserIdentity)
+			r0 = ret.Get(0).([]JobStatus)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(int64, *UserIdentity) error); ok {
+	if rf, ok := ret.Get(1).(func([]int64, *Ticket) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -107,22 +294,22 @@ func (_m *MockClient) CreateIdentity(_a0 int64, _a1 *UserIdentity) (*UserIdentit
 	return r0, r1
 }
 
-// CreateOrUpdateOrganization provides a mock function with given fields: _a0
-func (_m *MockClient) CreateOrUpdateOrganization(_a0 *Organization) (*Organization, error) {
-	ret := _m.Called(_a0)
+// BulkUpdateManyTicketsContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockClient) BulkUpdateManyTicketsContext(_a0 context.Context, _a1 []int64, _a2 *Ticket) ([]JobStatus, error) {
+	ret := _m.Called(_a0, _a1, _a2)
 
-	var r0 *Organization
-	if rf, ok := ret.Get(0).(func(*Organization) *Organization); ok {
-		r0 = rf(_a0)
+	var r0 []JobStatus
+	if rf, ok := ret.Get(0).(func(context.Context, []int64, *Ticket) []JobStatus); ok {
+		r0 = rf(_a0, _a1, _a2)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*Organization)
+			r0 = ret.Get(0).([]JobStatus)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*Organization) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, []int64, *Ticket) error); ok {
+		r1 = rf(_a0, _a1, _a2)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -130,21 +317,19 @@ func (_m *MockClient) CreateOrUpdateOrganization(_a0 *Organization) (*Organizati
 	return r0, r1
 }
 
-// CreateOrUpdateUser provides a mock function with given fields: _a0
-func (_m *MockClient) CreateOrUpdateUser(_a0 *User) (*User, error) {
+// CountSearch provides a mock function with given fields: _a0
+func (_m *MockClient) CountSearch(_a0 string) (int64, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *User
-	if rf, ok := ret.Get(0).(func(*User) *User); ok {
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(string) int64); ok {
 		r0 = rf(_a0)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*User)
-		}
+		r0 = ret.Get(0).(int64)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*User) error); ok {
+	if rf, ok := ret.Get(1).(func(string) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -153,21 +338,42 @@ func (_m *MockClient) CreateOrUpdateUser(_a0 *User) (*User, error) {
 	return r0, r1
 }
 
-// CreateOrganization provides a mock function with given fields: _a0
-func (_m *MockClient) CreateOrganization(_a0 *Organization) (*Organization, error) {
+// CountSearchContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) CountSearchContext(_a0 context.Context, _a1 string) (int64, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateGroup provides a mock function with given fields: _a0
+func (_m *MockClient) CreateGroup(_a0 *Group) (*Group, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *Organization
-	if rf, ok := ret.Get(0).(func(*Organization) *Organization); ok {
+	var r0 *Group
+	if rf, ok := ret.Get(0).(func(*Group) *Group); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*Organization)
+			r0 = ret.Get(0).(*Group)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*Organization) error); ok {
+	if rf, ok := ret.Get(1).(func(*Group) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -176,21 +382,4348 @@ func (_m *MockClient) CreateOrganization(_a0 *Organization) (*Organization, erro
 	return r0, r1
 }
 
-// CreateOrganizationMembership provides a mock function with given fields: _a0
-func (_m *MockClient) CreateOrganizationMembership(_a0 *OrganizationMembership) (*OrganizationMembership, error) {
-	ret := _m.Called(_a0)
+// CreateGroupContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) CreateGroupContext(_a0 context.Context, _a1 *Group) (*Group, error) {
+	ret := _m.Called(_a0, _a1)
 
-	var r0 *OrganizationMembership
-	if rf, ok := ret.Get(0).(func(*OrganizationMembership) *OrganizationMembership); ok {
+	var r0 *Group
+	if rf, ok := ret.Get(0).(func(context.Context, *Group) *Group); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Group)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *Group) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateIdentity provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) CreateIdentity(_a0 int64, _a1 *UserIdentity) (*UserIdentity, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *UserIdentity
+	if rf, ok := ret.Get(0).(func(int64, *UserIdentity) *UserIdentity); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*UserIdentity)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, *UserIdentity) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateIdentityContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockClient) CreateIdentityContext(_a0 context.Context, _a1 int64, _a2 *UserIdentity) (*UserIdentity, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *UserIdentity
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *UserIdentity) *UserIdentity); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*UserIdentity)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, *UserIdentity) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateManyOrganizationMemberships provides a mock function with given fields: _a0
+func (_m *MockClient) CreateManyOrganizationMemberships(_a0 []OrganizationMembership) ([]JobStatus, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []JobStatus
+	if rf, ok := ret.Get(0).(func([]OrganizationMembership) []JobStatus); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]JobStatus)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]OrganizationMembership) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateManyOrganizationMembershipsContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) CreateManyOrganizationMembershipsContext(_a0 context.Context, _a1 []OrganizationMembership) ([]JobStatus, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []JobStatus
+	if rf, ok := ret.Get(0).(func(context.Context, []OrganizationMembership) []JobStatus); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]JobStatus)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []OrganizationMembership) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateManyOrganizations provides a mock function with given fields: _a0
+func (_m *MockClient) CreateManyOrganizations(_a0 []Organization) ([]JobStatus, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []JobStatus
+	if rf, ok := ret.Get(0).(func([]Organization) []JobStatus); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]JobStatus)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]Organization) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateManyOrganizationsContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) CreateManyOrganizationsContext(_a0 context.Context, _a1 []Organization) ([]JobStatus, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []JobStatus
+	if rf, ok := ret.Get(0).(func(context.Context, []Organization) []JobStatus); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]JobStatus)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []Organization) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateManyTickets provides a mock function with given fields: _a0
+func (_m *MockClient) CreateManyTickets(_a0 []Ticket) ([]JobStatus, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []JobStatus
+	if rf, ok := ret.Get(0).(func([]Ticket) []JobStatus); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]JobStatus)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]Ticket) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateManyTicketsContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) CreateManyTicketsContext(_a0 context.Context, _a1 []Ticket) ([]JobStatus, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []JobStatus
+	if rf, ok := ret.Get(0).(func(context.Context, []Ticket) []JobStatus); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]JobStatus)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []Ticket) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateManyUsers provides a mock function with given fields: _a0
+func (_m *MockClient) CreateManyUsers(_a0 []User) ([]JobStatus, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []JobStatus
+	if rf, ok := ret.Get(0).(func([]User) []JobStatus); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]JobStatus)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]User) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateManyUsersContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) CreateManyUsersContext(_a0 context.Context, _a1 []User) ([]JobStatus, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []JobStatus
+	if rf, ok := ret.Get(0).(func(context.Context, []User) []JobStatus); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]JobStatus)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []User) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateOAuthClient provides a mock function with given fields: _a0
+func (_m *MockClient) CreateOAuthClient(_a0 *OAuthClient) (*OAuthClient, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *OAuthClient
+	if rf, ok := ret.Get(0).(func(*OAuthClient) *OAuthClient); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*OAuthClient)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*OAuthClient) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateOAuthClientContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) CreateOAuthClientContext(_a0 context.Context, _a1 *OAuthClient) (*OAuthClient, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *OAuthClient
+	if rf, ok := ret.Get(0).(func(context.Context, *OAuthClient) *OAuthClient); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*OAuthClient)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *OAuthClient) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateOAuthToken provides a mock function with given fields: _a0
+func (_m *MockClient) CreateOAuthToken(_a0 *OAuthToken) (*OAuthToken, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *OAuthToken
+	if rf, ok := ret.Get(0).(func(*OAuthToken) *OAuthToken); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*OAuthToken)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*OAuthToken) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateOAuthTokenContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) CreateOAuthTokenContext(_a0 context.Context, _a1 *OAuthToken) (*OAuthToken, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *OAuthToken
+	if rf, ok := ret.Get(0).(func(context.Context, *OAuthToken) *OAuthToken); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*OAuthToken)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *OAuthToken) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateOrUpdateManyUsers provides a mock function with given fields: _a0
+func (_m *MockClient) CreateOrUpdateManyUsers(_a0 []User) ([]JobStatus, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []JobStatus
+	if rf, ok := ret.Get(0).(func([]User) []JobStatus); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]JobStatus)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]User) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateOrUpdateManyUsersContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) CreateOrUpdateManyUsersContext(_a0 context.Context, _a1 []User) ([]JobStatus, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []JobStatus
+	if rf, ok := ret.Get(0).(func(context.Context, []User) []JobStatus); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]JobStatus)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []User) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateOrUpdateOrganization provides a mock function with given fields: _a0
+func (_m *MockClient) CreateOrUpdateOrganization(_a0 *Organization) (*Organization, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *Organization
+	if rf, ok := ret.Get(0).(func(*Organization) *Organization); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Organization)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*Organization) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateOrUpdateOrganizationContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) CreateOrUpdateOrganizationContext(_a0 context.Context, _a1 *Organization) (*Organization, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *Organization
+	if rf, ok := ret.Get(0).(func(context.Context, *Organization) *Organization); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Organization)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *Organization) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateOrUpdateUser provides a mock function with given fields: _a0
+func (_m *MockClient) CreateOrUpdateUser(_a0 *User) (*User, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *User
+	if rf, ok := ret.Get(0).(func(*User) *User); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*User)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*User) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateOrUpdateUserContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) CreateOrUpdateUserContext(_a0 context.Context, _a1 *User) (*User, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *User
+	if rf, ok := ret.Get(0).(func(context.Context, *User) *User); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*User)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *User) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateOrganization provides a mock function with given fields: _a0
+func (_m *MockClient) CreateOrganization(_a0 *Organization) (*Organization, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *Organization
+	if rf, ok := ret.Get(0).(func(*Organization) *Organization); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Organization)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*Organization) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateOrganizationContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) CreateOrganizationContext(_a0 context.Context, _a1 *Organization) (*Organization, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *Organization
+	if rf, ok := ret.Get(0).(func(context.Context, *Organization) *Organization); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Organization)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *Organization) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateOrganizationMembership provides a mock function with given fields: _a0
+func (_m *MockClient) CreateOrganizationMembership(_a0 *OrganizationMembership) (*OrganizationMembership, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *OrganizationMembership
+	if rf, ok := ret.Get(0).(func(*OrganizationMembership) *OrganizationMembership); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*OrganizationMembership)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*OrganizationMembership) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateOrganizationMembershipContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) CreateOrganizationMembershipContext(_a0 context.Context, _a1 *OrganizationMembership) (*OrganizationMembership, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *OrganizationMembership
+	if rf, ok := ret.Get(0).(func(context.Context, *OrganizationMembership) *OrganizationMembership); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*OrganizationMembership)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *OrganizationMembership) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateTicket provides a mock function with given fields: _a0
+func (_m *MockClient) CreateTicket(_a0 *Ticket) (*Ticket, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *Ticket
+	if rf, ok := ret.Get(0).(func(*Ticket) *Ticket); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Ticket)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*Ticket) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateTicketContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) CreateTicketContext(_a0 context.Context, _a1 *Ticket) (*Ticket, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *Ticket
+	if rf, ok := ret.Get(0).(func(context.Context, *Ticket) *Ticket); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Ticket)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *Ticket) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateUser provides a mock function with given fields: _a0
+func (_m *MockClient) CreateUser(_a0 *User) (*User, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *User
+	if rf, ok := ret.Get(0).(func(*User) *User); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*User)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*User) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateUserContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) CreateUserContext(_a0 context.Context, _a1 *User) (*User, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *User
+	if rf, ok := ret.Get(0).(func(context.Context, *User) *User); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*User)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *User) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteGroup provides a mock function with given fields: _a0
+func (_m *MockClient) DeleteGroup(_a0 int64) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteGroupContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) DeleteGroupContext(_a0 context.Context, _a1 int64) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteIdentity provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) DeleteIdentity(_a0 int64, _a1 int64) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64, int64) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteIdentityContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockClient) DeleteIdentityContext(_a0 context.Context, _a1 int64, _a2 int64) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteOAuthClient provides a mock function with given fields: _a0
+func (_m *MockClient) DeleteOAuthClient(_a0 int64) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteOAuthClientContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) DeleteOAuthClientContext(_a0 context.Context, _a1 int64) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteOrganization provides a mock function with given fields: _a0
+func (_m *MockClient) DeleteOrganization(_a0 int64) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteOrganizationContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) DeleteOrganizationContext(_a0 context.Context, _a1 int64) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteOrganizationMembershipByID provides a mock function with given fields: _a0
+func (_m *MockClient) DeleteOrganizationMembershipByID(_a0 int64) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteOrganizationMembershipByIDContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) DeleteOrganizationMembershipByIDContext(_a0 context.Context, _a1 int64) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteTicket provides a mock function with given fields: _a0
+func (_m *MockClient) DeleteTicket(_a0 int64) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteTicketContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) DeleteTicketContext(_a0 context.Context, _a1 int64) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteUser provides a mock function with given fields: _a0
+func (_m *MockClient) DeleteUser(_a0 int64) (*User, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *User
+	if rf, ok := ret.Get(0).(func(int64) *User); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*User)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteUserContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) DeleteUserContext(_a0 context.Context, _a1 int64) (*User, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *User
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *User); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*User)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DestroyManyOrganizationMemberships provides a mock function with given fields: _a0
+func (_m *MockClient) DestroyManyOrganizationMemberships(_a0 []int64) ([]JobStatus, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []JobStatus
+	if rf, ok := ret.Get(0).(func([]int64) []JobStatus); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]JobStatus)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]int64) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DestroyManyOrganizationMembershipsContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) DestroyManyOrganizationMembershipsContext(_a0 context.Context, _a1 []int64) ([]JobStatus, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []JobStatus
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) []JobStatus); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]JobStatus)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []int64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DestroyManyOrganizations provides a mock function with given fields: _a0
+func (_m *MockClient) DestroyManyOrganizations(_a0 []int64) ([]JobStatus, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []JobStatus
+	if rf, ok := ret.Get(0).(func([]int64) []JobStatus); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]JobStatus)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]int64) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DestroyManyOrganizationsContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) DestroyManyOrganizationsContext(_a0 context.Context, _a1 []int64) ([]JobStatus, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []JobStatus
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) []JobStatus); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]JobStatus)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []int64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DestroyManyTickets provides a mock function with given fields: _a0
+func (_m *MockClient) DestroyManyTickets(_a0 []int64) ([]JobStatus, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []JobStatus
+	if rf, ok := ret.Get(0).(func([]int64) []JobStatus); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]JobStatus)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]int64) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DestroyManyTicketsContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) DestroyManyTicketsContext(_a0 context.Context, _a1 []int64) ([]JobStatus, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []JobStatus
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) []JobStatus); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]JobStatus)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []int64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DestroyManyUsers provides a mock function with given fields: _a0
+func (_m *MockClient) DestroyManyUsers(_a0 []int64) ([]JobStatus, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []JobStatus
+	if rf, ok := ret.Get(0).(func([]int64) []JobStatus); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]JobStatus)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]int64) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DestroyManyUsersContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) DestroyManyUsersContext(_a0 context.Context, _a1 []int64) ([]JobStatus, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []JobStatus
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) []JobStatus); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]JobStatus)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []int64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IncrementalOrganizations provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) IncrementalOrganizations(_a0 *IncrementalOptions, _a1 ...SideLoad) (*IncrementalExport, error) {
+	_va := make([]interface{}, len(_a1))
+	for _i := range _a1 {
+		_va[_i] = _a1[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *IncrementalExport
+	if rf, ok := ret.Get(0).(func(*IncrementalOptions, ...SideLoad) *IncrementalExport); ok {
+		r0 = rf(_a0, _a1...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*IncrementalExport)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*IncrementalOptions, ...SideLoad) error); ok {
+		r1 = rf(_a0, _a1...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IncrementalOrganizationsContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockClient) IncrementalOrganizationsContext(_a0 context.Context, _a1 *IncrementalOptions, _a2 ...SideLoad) (*IncrementalExport, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *IncrementalExport
+	if rf, ok := ret.Get(0).(func(context.Context, *IncrementalOptions, ...SideLoad) *IncrementalExport); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*IncrementalExport)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *IncrementalOptions, ...SideLoad) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IncrementalTicketEvents provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) IncrementalTicketEvents(_a0 *IncrementalOptions, _a1 ...SideLoad) (*IncrementalExport, error) {
+	_va := make([]interface{}, len(_a1))
+	for _i := range _a1 {
+		_va[_i] = _a1[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *IncrementalExport
+	if rf, ok := ret.Get(0).(func(*IncrementalOptions, ...SideLoad) *IncrementalExport); ok {
+		r0 = rf(_a0, _a1...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*IncrementalExport)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*IncrementalOptions, ...SideLoad) error); ok {
+		r1 = rf(_a0, _a1...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IncrementalTicketEventsContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockClient) IncrementalTicketEventsContext(_a0 context.Context, _a1 *IncrementalOptions, _a2 ...SideLoad) (*IncrementalExport, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *IncrementalExport
+	if rf, ok := ret.Get(0).(func(context.Context, *IncrementalOptions, ...SideLoad) *IncrementalExport); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*IncrementalExport)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *IncrementalOptions, ...SideLoad) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IncrementalTicketEventsPager provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) IncrementalTicketEventsPager(_a0 *IncrementalOptions, _a1 ...SideLoad) *Pager[TicketEvent] {
+	_va := make([]interface{}, len(_a1))
+	for _i := range _a1 {
+		_va[_i] = _a1[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *Pager[TicketEvent]
+	if rf, ok := ret.Get(0).(func(*IncrementalOptions, ...SideLoad) *Pager[TicketEvent]); ok {
+		r0 = rf(_a0, _a1...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Pager[TicketEvent])
+		}
+	}
+
+	return r0
+}
+
+// IncrementalTicketMetricEvents provides a mock function with given fields: _a0
+func (_m *MockClient) IncrementalTicketMetricEvents(_a0 *IncrementalOptions) (*IncrementalExport, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *IncrementalExport
+	if rf, ok := ret.Get(0).(func(*IncrementalOptions) *IncrementalExport); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*IncrementalExport)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*IncrementalOptions) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IncrementalTicketMetricEventsContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) IncrementalTicketMetricEventsContext(_a0 context.Context, _a1 *IncrementalOptions) (*IncrementalExport, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *IncrementalExport
+	if rf, ok := ret.Get(0).(func(context.Context, *IncrementalOptions) *IncrementalExport); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*IncrementalExport)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *IncrementalOptions) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IncrementalTicketMetricEventsPager provides a mock function with given fields: _a0
+func (_m *MockClient) IncrementalTicketMetricEventsPager(_a0 *IncrementalOptions) *Pager[TicketMetricEvent] {
+	ret := _m.Called(_a0)
+
+	var r0 *Pager[TicketMetricEvent]
+	if rf, ok := ret.Get(0).(func(*IncrementalOptions) *Pager[TicketMetricEvent]); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Pager[TicketMetricEvent])
+		}
+	}
+
+	return r0
+}
+
+// IncrementalTickets provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) IncrementalTickets(_a0 *IncrementalOptions, _a1 ...SideLoad) (*IncrementalExport, error) {
+	_va := make([]interface{}, len(_a1))
+	for _i := range _a1 {
+		_va[_i] = _a1[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *IncrementalExport
+	if rf, ok := ret.Get(0).(func(*IncrementalOptions, ...SideLoad) *IncrementalExport); ok {
+		r0 = rf(_a0, _a1...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*IncrementalExport)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*IncrementalOptions, ...SideLoad) error); ok {
+		r1 = rf(_a0, _a1...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IncrementalTicketsContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockClient) IncrementalTicketsContext(_a0 context.Context, _a1 *IncrementalOptions, _a2 ...SideLoad) (*IncrementalExport, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *IncrementalExport
+	if rf, ok := ret.Get(0).(func(context.Context, *IncrementalOptions, ...SideLoad) *IncrementalExport); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*IncrementalExport)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *IncrementalOptions, ...SideLoad) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IncrementalUsers provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) IncrementalUsers(_a0 *IncrementalOptions, _a1 ...SideLoad) (*IncrementalExport, error) {
+	_va := make([]interface{}, len(_a1))
+	for _i := range _a1 {
+		_va[_i] = _a1[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *IncrementalExport
+	if rf, ok := ret.Get(0).(func(*IncrementalOptions, ...SideLoad) *IncrementalExport); ok {
+		r0 = rf(_a0, _a1...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*IncrementalExport)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*IncrementalOptions, ...SideLoad) error); ok {
+		r1 = rf(_a0, _a1...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IncrementalUsersContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockClient) IncrementalUsersContext(_a0 context.Context, _a1 *IncrementalOptions, _a2 ...SideLoad) (*IncrementalExport, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *IncrementalExport
+	if rf, ok := ret.Get(0).(func(context.Context, *IncrementalOptions, ...SideLoad) *IncrementalExport); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*IncrementalExport)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *IncrementalOptions, ...SideLoad) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListExternalIDTickets provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockClient) ListExternalIDTickets(_a0 string, _a1 *ListOptions, _a2 ...SideLoad) (*ListResponse, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ListResponse
+	if rf, ok := ret.Get(0).(func(string, *ListOptions, ...SideLoad) *ListResponse); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ListResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, *ListOptions, ...SideLoad) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListExternalIDTicketsContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *MockClient) ListExternalIDTicketsContext(_a0 context.Context, _a1 string, _a2 *ListOptions, _a3 ...SideLoad) (*ListResponse, error) {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ListResponse
+	if rf, ok := ret.Get(0).(func(context.Context, string, *ListOptions, ...SideLoad) *ListResponse); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ListResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, *ListOptions, ...SideLoad) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListExternalIDTicketsPager provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockClient) ListExternalIDTicketsPager(_a0 string, _a1 *ListOptions, _a2 ...SideLoad) *Pager[Ticket] {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *Pager[Ticket]
+	if rf, ok := ret.Get(0).(func(string, *ListOptions, ...SideLoad) *Pager[Ticket]); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Pager[Ticket])
+		}
+	}
+
+	return r0
+}
+
+// ListGroups provides a mock function with given fields:
+func (_m *MockClient) ListGroups() ([]Group, error) {
+	ret := _m.Called()
+
+	var r0 []Group
+	if rf, ok := ret.Get(0).(func() []Group); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Group)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListGroupsContext provides a mock function with given fields: _a0
+func (_m *MockClient) ListGroupsContext(_a0 context.Context) ([]Group, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []Group
+	if rf, ok := ret.Get(0).(func(context.Context) []Group); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Group)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListGroupsPager provides a mock function with given fields: _a0
+func (_m *MockClient) ListGroupsPager(_a0 *ListOptions) *Pager[Group] {
+	ret := _m.Called(_a0)
+
+	var r0 *Pager[Group]
+	if rf, ok := ret.Get(0).(func(*ListOptions) *Pager[Group]); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Pager[Group])
+		}
+	}
+
+	return r0
+}
+
+// ListIdentities provides a mock function with given fields: _a0
+func (_m *MockClient) ListIdentities(_a0 int64) ([]UserIdentity, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []UserIdentity
+	if rf, ok := ret.Get(0).(func(int64) []UserIdentity); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]UserIdentity)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListIdentitiesContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) ListIdentitiesContext(_a0 context.Context, _a1 int64) ([]UserIdentity, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []UserIdentity
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []UserIdentity); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]UserIdentity)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListJobStatuses provides a mock function with given fields:
+func (_m *MockClient) ListJobStatuses() ([]JobStatus, error) {
+	ret := _m.Called()
+
+	var r0 []JobStatus
+	if rf, ok := ret.Get(0).(func() []JobStatus); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]JobStatus)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListJobStatusesContext provides a mock function with given fields: _a0
+func (_m *MockClient) ListJobStatusesContext(_a0 context.Context) ([]JobStatus, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []JobStatus
+	if rf, ok := ret.Get(0).(func(context.Context) []JobStatus); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]JobStatus)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListLocales provides a mock function with given fields:
+func (_m *MockClient) ListLocales() ([]Locale, error) {
+	ret := _m.Called()
+
+	var r0 []Locale
+	if rf, ok := ret.Get(0).(func() []Locale); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Locale)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListLocalesContext provides a mock function with given fields: _a0
+func (_m *MockClient) ListLocalesContext(_a0 context.Context) ([]Locale, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []Locale
+	if rf, ok := ret.Get(0).(func(context.Context) []Locale); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Locale)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListOAuthClients provides a mock function with given fields:
+func (_m *MockClient) ListOAuthClients() ([]OAuthClient, error) {
+	ret := _m.Called()
+
+	var r0 []OAuthClient
+	if rf, ok := ret.Get(0).(func() []OAuthClient); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]OAuthClient)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListOAuthClientsContext provides a mock function with given fields: _a0
+func (_m *MockClient) ListOAuthClientsContext(_a0 context.Context) ([]OAuthClient, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []OAuthClient
+	if rf, ok := ret.Get(0).(func(context.Context) []OAuthClient); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]OAuthClient)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListOAuthTokens provides a mock function with given fields: _a0
+func (_m *MockClient) ListOAuthTokens(_a0 *ListOAuthTokensOptions) ([]OAuthToken, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []OAuthToken
+	if rf, ok := ret.Get(0).(func(*ListOAuthTokensOptions) []OAuthToken); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]OAuthToken)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*ListOAuthTokensOptions) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListOAuthTokensContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) ListOAuthTokensContext(_a0 context.Context, _a1 *ListOAuthTokensOptions) ([]OAuthToken, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []OAuthToken
+	if rf, ok := ret.Get(0).(func(context.Context, *ListOAuthTokensOptions) []OAuthToken); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]OAuthToken)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ListOAuthTokensOptions) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListOrganizationMembershipsByUserID provides a mock function with given fields: id
+func (_m *MockClient) ListOrganizationMembershipsByUserID(id int64) ([]OrganizationMembership, error) {
+	ret := _m.Called(id)
+
+	var r0 []OrganizationMembership
+	if rf, ok := ret.Get(0).(func(int64) []OrganizationMembership); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]OrganizationMembership)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListOrganizationMembershipsByUserIDContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) ListOrganizationMembershipsByUserIDContext(_a0 context.Context, _a1 int64) ([]OrganizationMembership, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []OrganizationMembership
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []OrganizationMembership); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]OrganizationMembership)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListOrganizationMembershipsCursor provides a mock function with given fields: _a0
+func (_m *MockClient) ListOrganizationMembershipsCursor(_a0 *CursorOptions) (*ListResponse, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *ListResponse
+	if rf, ok := ret.Get(0).(func(*CursorOptions) *ListResponse); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ListResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*CursorOptions) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListOrganizationMembershipsCursorContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) ListOrganizationMembershipsCursorContext(_a0 context.Context, _a1 *CursorOptions) (*ListResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *ListResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *CursorOptions) *ListResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ListResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *CursorOptions) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListOrganizationTags provides a mock function with given fields: _a0
+func (_m *MockClient) ListOrganizationTags(_a0 int64) ([]string, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(int64) []string); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListOrganizationTagsContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) ListOrganizationTagsContext(_a0 context.Context, _a1 int64) ([]string, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []string); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListOrganizationTickets provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockClient) ListOrganizationTickets(_a0 int64, _a1 *ListOptions, _a2 ...SideLoad) (*ListResponse, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ListResponse
+	if rf, ok := ret.Get(0).(func(int64, *ListOptions, ...SideLoad) *ListResponse); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ListResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, *ListOptions, ...SideLoad) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListOrganizationTicketsContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *MockClient) ListOrganizationTicketsContext(_a0 context.Context, _a1 int64, _a2 *ListOptions, _a3 ...SideLoad) (*ListResponse, error) {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ListResponse
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *ListOptions, ...SideLoad) *ListResponse); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ListResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, *ListOptions, ...SideLoad) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListOrganizationTicketsPager provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockClient) ListOrganizationTicketsPager(_a0 int64, _a1 *ListOptions, _a2 ...SideLoad) *Pager[Ticket] {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *Pager[Ticket]
+	if rf, ok := ret.Get(0).(func(int64, *ListOptions, ...SideLoad) *Pager[Ticket]); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Pager[Ticket])
+		}
+	}
+
+	return r0
+}
+
+// ListOrganizationUsers provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) ListOrganizationUsers(_a0 int64, _a1 *ListUsersOptions) ([]User, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []User
+	if rf, ok := ret.Get(0).(func(int64, *ListUsersOptions) []User); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]User)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, *ListUsersOptions) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListOrganizationUsersContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockClient) ListOrganizationUsersContext(_a0 context.Context, _a1 int64, _a2 *ListUsersOptions) ([]User, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 []User
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *ListUsersOptions) []User); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]User)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, *ListUsersOptions) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListOrganizationUsersPager provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) ListOrganizationUsersPager(_a0 int64, _a1 *ListUsersOptions) *Pager[User] {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *Pager[User]
+	if rf, ok := ret.Get(0).(func(int64, *ListUsersOptions) *Pager[User]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Pager[User])
+		}
+	}
+
+	return r0
+}
+
+// ListOrganizations provides a mock function with given fields: _a0
+func (_m *MockClient) ListOrganizations(_a0 *ListOptions) ([]Organization, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []Organization
+	if rf, ok := ret.Get(0).(func(*ListOptions) []Organization); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Organization)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*ListOptions) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListOrganizationsContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) ListOrganizationsContext(_a0 context.Context, _a1 *ListOptions) ([]Organization, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []Organization
+	if rf, ok := ret.Get(0).(func(context.Context, *ListOptions) []Organization); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Organization)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ListOptions) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListOrganizationsCursor provides a mock function with given fields: _a0
+func (_m *MockClient) ListOrganizationsCursor(_a0 *CursorOptions) (*ListResponse, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *ListResponse
+	if rf, ok := ret.Get(0).(func(*CursorOptions) *ListResponse); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ListResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*CursorOptions) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListOrganizationsCursorContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) ListOrganizationsCursorContext(_a0 context.Context, _a1 *CursorOptions) (*ListResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *ListResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *CursorOptions) *ListResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ListResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *CursorOptions) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListOrganizationsPager provides a mock function with given fields: _a0
+func (_m *MockClient) ListOrganizationsPager(_a0 *ListOptions) *Pager[Organization] {
+	ret := _m.Called(_a0)
+
+	var r0 *Pager[Organization]
+	if rf, ok := ret.Get(0).(func(*ListOptions) *Pager[Organization]); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Pager[Organization])
+		}
+	}
+
+	return r0
+}
+
+// ListRequestedTickets provides a mock function with given fields: _a0
+func (_m *MockClient) ListRequestedTickets(_a0 int64) ([]Ticket, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []Ticket
+	if rf, ok := ret.Get(0).(func(int64) []Ticket); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Ticket)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRequestedTicketsContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) ListRequestedTicketsContext(_a0 context.Context, _a1 int64) ([]Ticket, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []Ticket
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []Ticket); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Ticket)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTags provides a mock function with given fields: _a0
+func (_m *MockClient) ListTags(_a0 *ListOptions) ([]Tag, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []Tag
+	if rf, ok := ret.Get(0).(func(*ListOptions) []Tag); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Tag)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*ListOptions) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTagsContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) ListTagsContext(_a0 context.Context, _a1 *ListOptions) ([]Tag, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []Tag
+	if rf, ok := ret.Get(0).(func(context.Context, *ListOptions) []Tag); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Tag)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ListOptions) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTicketAudits provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) ListTicketAudits(_a0 int64, _a1 *ListOptions) (*ListResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *ListResponse
+	if rf, ok := ret.Get(0).(func(int64, *ListOptions) *ListResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ListResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, *ListOptions) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTicketAuditsContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockClient) ListTicketAuditsContext(_a0 context.Context, _a1 int64, _a2 *ListOptions) (*ListResponse, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *ListResponse
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *ListOptions) *ListResponse); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ListResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, *ListOptions) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTicketAuditsCursor provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) ListTicketAuditsCursor(_a0 int64, _a1 *CursorOptions) (*ListResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *ListResponse
+	if rf, ok := ret.Get(0).(func(int64, *CursorOptions) *ListResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ListResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, *CursorOptions) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTicketAuditsCursorContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockClient) ListTicketAuditsCursorContext(_a0 context.Context, _a1 int64, _a2 *CursorOptions) (*ListResponse, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *ListResponse
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *CursorOptions) *ListResponse); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ListResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, *CursorOptions) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTicketAuditsPager provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) ListTicketAuditsPager(_a0 int64, _a1 *ListOptions) *Pager[TicketAudit] {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *Pager[TicketAudit]
+	if rf, ok := ret.Get(0).(func(int64, *ListOptions) *Pager[TicketAudit]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Pager[TicketAudit])
+		}
+	}
+
+	return r0
+}
+
+// ListTicketCollaborators provides a mock function with given fields: _a0
+func (_m *MockClient) ListTicketCollaborators(_a0 int64) ([]User, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []User
+	if rf, ok := ret.Get(0).(func(int64) []User); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]User)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTicketCollaboratorsContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) ListTicketCollaboratorsContext(_a0 context.Context, _a1 int64) ([]User, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []User
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []User); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]User)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTicketComments provides a mock function with given fields: _a0
+func (_m *MockClient) ListTicketComments(_a0 int64) ([]TicketComment, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []TicketComment
+	if rf, ok := ret.Get(0).(func(int64) []TicketComment); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]TicketComment)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTicketCommentsContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) ListTicketCommentsContext(_a0 context.Context, _a1 int64) ([]TicketComment, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []TicketComment
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []TicketComment); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]TicketComment)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTicketCommentsCursor provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockClient) ListTicketCommentsCursor(_a0 int64, _a1 *CursorOptions, _a2 ...SideLoad) (*ListResponse, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ListResponse
+	if rf, ok := ret.Get(0).(func(int64, *CursorOptions, ...SideLoad) *ListResponse); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ListResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, *CursorOptions, ...SideLoad) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTicketCommentsCursorContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *MockClient) ListTicketCommentsCursorContext(_a0 context.Context, _a1 int64, _a2 *CursorOptions, _a3 ...SideLoad) (*ListResponse, error) {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ListResponse
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *CursorOptions, ...SideLoad) *ListResponse); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ListResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, *CursorOptions, ...SideLoad) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTicketCommentsFull provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockClient) ListTicketCommentsFull(_a0 int64, _a1 *ListOptions, _a2 ...SideLoad) (*ListResponse, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ListResponse
+	if rf, ok := ret.Get(0).(func(int64, *ListOptions, ...SideLoad) *ListResponse); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ListResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, *ListOptions, ...SideLoad) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTicketCommentsFullContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *MockClient) ListTicketCommentsFullContext(_a0 context.Context, _a1 int64, _a2 *ListOptions, _a3 ...SideLoad) (*ListResponse, error) {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ListResponse
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *ListOptions, ...SideLoad) *ListResponse); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ListResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, *ListOptions, ...SideLoad) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTicketCommentsPager provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockClient) ListTicketCommentsPager(_a0 int64, _a1 *ListOptions, _a2 ...SideLoad) *Pager[TicketComment] {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *Pager[TicketComment]
+	if rf, ok := ret.Get(0).(func(int64, *ListOptions, ...SideLoad) *Pager[TicketComment]); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Pager[TicketComment])
+		}
+	}
+
+	return r0
+}
+
+// ListTicketEmailCCs provides a mock function with given fields: _a0
+func (_m *MockClient) ListTicketEmailCCs(_a0 int64) ([]User, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []User
+	if rf, ok := ret.Get(0).(func(int64) []User); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]User)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTicketEmailCCsContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) ListTicketEmailCCsContext(_a0 context.Context, _a1 int64) ([]User, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []User
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []User); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]User)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTicketFields provides a mock function with given fields:
+func (_m *MockClient) ListTicketFields() ([]TicketField, error) {
+	ret := _m.Called()
+
+	var r0 []TicketField
+	if rf, ok := ret.Get(0).(func() []TicketField); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]TicketField)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTicketFieldsContext provides a mock function with given fields: _a0
+func (_m *MockClient) ListTicketFieldsContext(_a0 context.Context) ([]TicketField, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []TicketField
+	if rf, ok := ret.Get(0).(func(context.Context) []TicketField); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]TicketField)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTicketFollowers provides a mock function with given fields: _a0
+func (_m *MockClient) ListTicketFollowers(_a0 int64) ([]User, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []User
+	if rf, ok := ret.Get(0).(func(int64) []User); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]User)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTicketFollowersContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) ListTicketFollowersContext(_a0 context.Context, _a1 int64) ([]User, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []User
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []User); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]User)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTicketIncidents provides a mock function with given fields: _a0
+func (_m *MockClient) ListTicketIncidents(_a0 int64) ([]Ticket, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []Ticket
+	if rf, ok := ret.Get(0).(func(int64) []Ticket); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Ticket)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTicketIncidentsContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) ListTicketIncidentsContext(_a0 context.Context, _a1 int64) ([]Ticket, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []Ticket
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []Ticket); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Ticket)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTicketTags provides a mock function with given fields: _a0
+func (_m *MockClient) ListTicketTags(_a0 int64) ([]string, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(int64) []string); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTicketTagsContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) ListTicketTagsContext(_a0 context.Context, _a1 int64) ([]string, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []string); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTickets provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) ListTickets(_a0 *ListOptions, _a1 ...SideLoad) (*ListResponse, error) {
+	_va := make([]interface{}, len(_a1))
+	for _i := range _a1 {
+		_va[_i] = _a1[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ListResponse
+	if rf, ok := ret.Get(0).(func(*ListOptions, ...SideLoad) *ListResponse); ok {
+		r0 = rf(_a0, _a1...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ListResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*ListOptions, ...SideLoad) error); ok {
+		r1 = rf(_a0, _a1...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTicketsContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockClient) ListTicketsContext(_a0 context.Context, _a1 *ListOptions, _a2 ...SideLoad) (*ListResponse, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ListResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *ListOptions, ...SideLoad) *ListResponse); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ListResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ListOptions, ...SideLoad) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTicketsCursor provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) ListTicketsCursor(_a0 *CursorOptions, _a1 ...SideLoad) (*ListResponse, error) {
+	_va := make([]interface{}, len(_a1))
+	for _i := range _a1 {
+		_va[_i] = _a1[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ListResponse
+	if rf, ok := ret.Get(0).(func(*CursorOptions, ...SideLoad) *ListResponse); ok {
+		r0 = rf(_a0, _a1...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ListResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*CursorOptions, ...SideLoad) error); ok {
+		r1 = rf(_a0, _a1...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTicketsCursorContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockClient) ListTicketsCursorContext(_a0 context.Context, _a1 *CursorOptions, _a2 ...SideLoad) (*ListResponse, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ListResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *CursorOptions, ...SideLoad) *ListResponse); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ListResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *CursorOptions, ...SideLoad) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTicketsPager provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) ListTicketsPager(_a0 *ListOptions, _a1 ...SideLoad) *Pager[Ticket] {
+	_va := make([]interface{}, len(_a1))
+	for _i := range _a1 {
+		_va[_i] = _a1[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *Pager[Ticket]
+	if rf, ok := ret.Get(0).(func(*ListOptions, ...SideLoad) *Pager[Ticket]); ok {
+		r0 = rf(_a0, _a1...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Pager[Ticket])
+		}
+	}
+
+	return r0
+}
+
+// ListUserTags provides a mock function with given fields: _a0
+func (_m *MockClient) ListUserTags(_a0 int64) ([]string, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(int64) []string); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListUserTagsContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) ListUserTagsContext(_a0 context.Context, _a1 int64) ([]string, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []string); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListUsers provides a mock function with given fields: _a0
+func (_m *MockClient) ListUsers(_a0 *ListUsersOptions) ([]User, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []User
+	if rf, ok := ret.Get(0).(func(*ListUsersOptions) []User); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]User)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*ListUsersOptions) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListUsersContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) ListUsersContext(_a0 context.Context, _a1 *ListUsersOptions) ([]User, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []User
+	if rf, ok := ret.Get(0).(func(context.Context, *ListUsersOptions) []User); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]User)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ListUsersOptions) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListUsersCursor provides a mock function with given fields: _a0
+func (_m *MockClient) ListUsersCursor(_a0 *ListUsersCursorOptions) (*ListResponse, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *ListResponse
+	if rf, ok := ret.Get(0).(func(*ListUsersCursorOptions) *ListResponse); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ListResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*ListUsersCursorOptions) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListUsersCursorContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) ListUsersCursorContext(_a0 context.Context, _a1 *ListUsersCursorOptions) (*ListResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *ListResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *ListUsersCursorOptions) *ListResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ListResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ListUsersCursorOptions) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListUsersPager provides a mock function with given fields: _a0
+func (_m *MockClient) ListUsersPager(_a0 *ListUsersOptions) *Pager[User] {
+	ret := _m.Called(_a0)
+
+	var r0 *Pager[User]
+	if rf, ok := ret.Get(0).(func(*ListUsersOptions) *Pager[User]); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Pager[User])
+		}
+	}
+
+	return r0
+}
+
+// MakeIdentityPrimary provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) MakeIdentityPrimary(_a0 int64, _a1 int64) ([]UserIdentity, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []UserIdentity
+	if rf, ok := ret.Get(0).(func(int64, int64) []UserIdentity); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]UserIdentity)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, int64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MakeIdentityPrimaryContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockClient) MakeIdentityPrimaryContext(_a0 context.Context, _a1 int64, _a2 int64) ([]UserIdentity, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 []UserIdentity
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) []UserIdentity); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]UserIdentity)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MergeTickets provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) MergeTickets(_a0 int64, _a1 *TicketMergeRequest) (*JobStatus, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *JobStatus
+	if rf, ok := ret.Get(0).(func(int64, *TicketMergeRequest) *JobStatus); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*JobStatus)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, *TicketMergeRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MergeTicketsContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockClient) MergeTicketsContext(_a0 context.Context, _a1 int64, _a2 *TicketMergeRequest) (*JobStatus, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *JobStatus
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *TicketMergeRequest) *JobStatus); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*JobStatus)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, *TicketMergeRequest) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PermanentlyDeleteTicket provides a mock function with given fields: _a0
+func (_m *MockClient) PermanentlyDeleteTicket(_a0 int64) (*JobStatus, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *JobStatus
+	if rf, ok := ret.Get(0).(func(int64) *JobStatus); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*JobStatus)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PermanentlyDeleteTicketContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) PermanentlyDeleteTicketContext(_a0 context.Context, _a1 int64) (*JobStatus, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *JobStatus
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *JobStatus); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*JobStatus)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PermanentlyDeleteUser provides a mock function with given fields: _a0
+func (_m *MockClient) PermanentlyDeleteUser(_a0 int64) (*User, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *User
+	if rf, ok := ret.Get(0).(func(int64) *User); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*User)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PermanentlyDeleteUserContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) PermanentlyDeleteUserContext(_a0 context.Context, _a1 int64) (*User, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *User
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *User); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*User)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RedactCommentString provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockClient) RedactCommentString(_a0 int64, _a1 int64, _a2 string) (*TicketComment, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *TicketComment
+	if rf, ok := ret.Get(0).(func(int64, int64, string) *TicketComment); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*TicketComment)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, int64, string) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RedactCommentStringContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *MockClient) RedactCommentStringContext(_a0 context.Context, _a1 int64, _a2 int64, _a3 string) (*TicketComment, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	var r0 *TicketComment
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, string) *TicketComment); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*TicketComment)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, string) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveOrganizationTags provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) RemoveOrganizationTags(_a0 int64, _a1 []string) ([]string, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(int64, []string) []string); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, []string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveOrganizationTagsContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockClient) RemoveOrganizationTagsContext(_a0 context.Context, _a1 int64, _a2 []string) ([]string, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []string) []string); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, []string) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveTicketTags provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) RemoveTicketTags(_a0 int64, _a1 []string) ([]string, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(int64, []string) []string); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, []string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveTicketTagsContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockClient) RemoveTicketTagsContext(_a0 context.Context, _a1 int64, _a2 []string) ([]string, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []string) []string); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, []string) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveUserTags provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) RemoveUserTags(_a0 int64, _a1 []string) ([]string, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(int64, []string) []string); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, []string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveUserTagsContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockClient) RemoveUserTagsContext(_a0 context.Context, _a1 int64, _a2 []string) ([]string, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []string) []string); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, []string) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RevokeOAuthToken provides a mock function with given fields: _a0
+func (_m *MockClient) RevokeOAuthToken(_a0 int64) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RevokeOAuthTokenContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) RevokeOAuthTokenContext(_a0 context.Context, _a1 int64) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Search provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) Search(_a0 string, _a1 *ListOptions) (*SearchResults, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *SearchResults
+	if rf, ok := ret.Get(0).(func(string, *ListOptions) *SearchResults); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*SearchResults)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, *ListOptions) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchAllTickets provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *MockClient) SearchAllTickets(_a0 context.Context, _a1 string, _a2 *WindowSearchOptions, _a3 ...Filters) iter.Seq2[Ticket, error] {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 iter.Seq2[Ticket, error]
+	if rf, ok := ret.Get(0).(func(context.Context, string, *WindowSearchOptions, ...Filters) iter.Seq2[Ticket, error]); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Get(0).(iter.Seq2[Ticket, error])
+	}
+
+	return r0
+}
+
+// SearchContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockClient) SearchContext(_a0 context.Context, _a1 string, _a2 *ListOptions) (*SearchResults, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *SearchResults
+	if rf, ok := ret.Get(0).(func(context.Context, string, *ListOptions) *SearchResults); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*SearchResults)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, *ListOptions) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchExport provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockClient) SearchExport(_a0 string, _a1 ResultType, _a2 *CursorOptions) (*SearchExportResults, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *SearchExportResults
+	if rf, ok := ret.Get(0).(func(string, ResultType, *CursorOptions) *SearchExportResults); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*SearchExportResults)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, ResultType, *CursorOptions) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchExportContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *MockClient) SearchExportContext(_a0 context.Context, _a1 string, _a2 ResultType, _a3 *CursorOptions) (*SearchExportResults, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	var r0 *SearchExportResults
+	if rf, ok := ret.Get(0).(func(context.Context, string, ResultType, *CursorOptions) *SearchExportResults); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*SearchExportResults)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, ResultType, *CursorOptions) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchGroups provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockClient) SearchGroups(_a0 string, _a1 *ListOptions, _a2 ...Filters) (*GroupSearchResults, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *GroupSearchResults
+	if rf, ok := ret.Get(0).(func(string, *ListOptions, ...Filters) *GroupSearchResults); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*GroupSearchResults)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, *ListOptions, ...Filters) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchGroupsContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *MockClient) SearchGroupsContext(_a0 context.Context, _a1 string, _a2 *ListOptions, _a3 ...Filters) (*GroupSearchResults, error) {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *GroupSearchResults
+	if rf, ok := ret.Get(0).(func(context.Context, string, *ListOptions, ...Filters) *GroupSearchResults); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*GroupSearchResults)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, *ListOptions, ...Filters) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchOrganizations provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockClient) SearchOrganizations(_a0 string, _a1 *ListOptions, _a2 ...Filters) (*OrganizationSearchResults, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *OrganizationSearchResults
+	if rf, ok := ret.Get(0).(func(string, *ListOptions, ...Filters) *OrganizationSearchResults); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*OrganizationSearchResults)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, *ListOptions, ...Filters) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchOrganizationsByExternalID provides a mock function with given fields: _a0
+func (_m *MockClient) SearchOrganizationsByExternalID(_a0 string) ([]Organization, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []Organization
+	if rf, ok := ret.Get(0).(func(string) []Organization); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Organization)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchOrganizationsByExternalIDContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) SearchOrganizationsByExternalIDContext(_a0 context.Context, _a1 string) ([]Organization, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []Organization
+	if rf, ok := ret.Get(0).(func(context.Context, string) []Organization); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Organization)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchOrganizationsContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *MockClient) SearchOrganizationsContext(_a0 context.Context, _a1 string, _a2 *ListOptions, _a3 ...Filters) (*OrganizationSearchResults, error) {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *OrganizationSearchResults
+	if rf, ok := ret.Get(0).(func(context.Context, string, *ListOptions, ...Filters) *OrganizationSearchResults); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*OrganizationSearchResults)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, *ListOptions, ...Filters) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchTickets provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockClient) SearchTickets(_a0 string, _a1 *ListOptions, _a2 ...Filters) (*TicketSearchResults, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *TicketSearchResults
+	if rf, ok := ret.Get(0).(func(string, *ListOptions, ...Filters) *TicketSearchResults); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*TicketSearchResults)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, *ListOptions, ...Filters) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchTicketsContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *MockClient) SearchTicketsContext(_a0 context.Context, _a1 string, _a2 *ListOptions, _a3 ...Filters) (*TicketSearchResults, error) {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *TicketSearchResults
+	if rf, ok := ret.Get(0).(func(context.Context, string, *ListOptions, ...Filters) *TicketSearchResults); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*TicketSearchResults)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, *ListOptions, ...Filters) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchUserByExternalID provides a mock function with given fields: _a0
+func (_m *MockClient) SearchUserByExternalID(_a0 string) (*User, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *User
+	if rf, ok := ret.Get(0).(func(string) *User); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*User)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchUserByExternalIDContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) SearchUserByExternalIDContext(_a0 context.Context, _a1 string) (*User, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *User
+	if rf, ok := ret.Get(0).(func(context.Context, string) *User); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*User)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchUsers provides a mock function with given fields: _a0
+func (_m *MockClient) SearchUsers(_a0 string) ([]User, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []User
+	if rf, ok := ret.Get(0).(func(string) []User); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]User)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchUsersContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) SearchUsersContext(_a0 context.Context, _a1 string) ([]User, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []User
+	if rf, ok := ret.Get(0).(func(context.Context, string) []User); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]User)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchUsersEx provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockClient) SearchUsersEx(_a0 string, _a1 *ListOptions, _a2 ...Filters) (*UserSearchResults, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *UserSearchResults
+	if rf, ok := ret.Get(0).(func(string, *ListOptions, ...Filters) *UserSearchResults); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*UserSearchResults)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, *ListOptions, ...Filters) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchUsersExContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *MockClient) SearchUsersExContext(_a0 context.Context, _a1 string, _a2 *ListOptions, _a3 ...Filters) (*UserSearchResults, error) {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *UserSearchResults
+	if rf, ok := ret.Get(0).(func(context.Context, string, *ListOptions, ...Filters) *UserSearchResults); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*UserSearchResults)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, *ListOptions, ...Filters) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetOrganizationTags provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) SetOrganizationTags(_a0 int64, _a1 []string) ([]string, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(int64, []string) []string); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, []string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetOrganizationTagsContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockClient) SetOrganizationTagsContext(_a0 context.Context, _a1 int64, _a2 []string) ([]string, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []string) []string); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, []string) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetTicketTags provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) SetTicketTags(_a0 int64, _a1 []string) ([]string, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(int64, []string) []string); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, []string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetTicketTagsContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockClient) SetTicketTagsContext(_a0 context.Context, _a1 int64, _a2 []string) ([]string, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []string) []string); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, []string) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetUserTags provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) SetUserTags(_a0 int64, _a1 []string) ([]string, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(int64, []string) []string); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, []string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetUserTagsContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockClient) SetUserTagsContext(_a0 context.Context, _a1 int64, _a2 []string) ([]string, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []string) []string); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, []string) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ShowComplianceDeletionStatuses provides a mock function with given fields: _a0
+func (_m *MockClient) ShowComplianceDeletionStatuses(_a0 int64) ([]ComplianceDeletionStatus, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []ComplianceDeletionStatus
+	if rf, ok := ret.Get(0).(func(int64) []ComplianceDeletionStatus); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]ComplianceDeletionStatus)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ShowComplianceDeletionStatusesContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) ShowComplianceDeletionStatusesContext(_a0 context.Context, _a1 int64) ([]ComplianceDeletionStatus, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []ComplianceDeletionStatus
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []ComplianceDeletionStatus); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]ComplianceDeletionStatus)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ShowGroup provides a mock function with given fields: _a0
+func (_m *MockClient) ShowGroup(_a0 int64) (*Group, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *Group
+	if rf, ok := ret.Get(0).(func(int64) *Group); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Group)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ShowGroupContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) ShowGroupContext(_a0 context.Context, _a1 int64) (*Group, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *Group
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *Group); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Group)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ShowIdentity provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) ShowIdentity(_a0 int64, _a1 int64) (*UserIdentity, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *UserIdentity
+	if rf, ok := ret.Get(0).(func(int64, int64) *UserIdentity); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*UserIdentity)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, int64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ShowIdentityContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockClient) ShowIdentityContext(_a0 context.Context, _a1 int64, _a2 int64) (*UserIdentity, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *UserIdentity
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) *UserIdentity); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*UserIdentity)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ShowJobStatus provides a mock function with given fields: _a0
+func (_m *MockClient) ShowJobStatus(_a0 string) (*JobStatus, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *JobStatus
+	if rf, ok := ret.Get(0).(func(string) *JobStatus); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*JobStatus)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ShowJobStatusContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) ShowJobStatusContext(_a0 context.Context, _a1 string) (*JobStatus, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *JobStatus
+	if rf, ok := ret.Get(0).(func(context.Context, string) *JobStatus); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*JobStatus)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ShowLocale provides a mock function with given fields: _a0
+func (_m *MockClient) ShowLocale(_a0 int64) (*Locale, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *Locale
+	if rf, ok := ret.Get(0).(func(int64) *Locale); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*OrganizationMembership)
+			r0 = ret.Get(0).(*Locale)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*OrganizationMembership) error); ok {
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -199,21 +4732,21 @@ func (_m *MockClient) CreateOrganizationMembership(_a0 *OrganizationMembership)
 	return r0, r1
 }
 
-// CreateTicket provides a mock function with given fields: _a0
-func (_m *MockClient) CreateTicket(_a0 *Ticket) (*Ticket, error) {
+// ShowLocaleByCode provides a mock function with given fields: _a0
+func (_m *MockClient) ShowLocaleByCode(_a0 string) (*Locale, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *Ticket
-	if rf, ok := ret.Get(0).(func(*Ticket) *Ticket); ok {
+	var r0 *Locale
+	if rf, ok := ret.Get(0).(func(string) *Locale); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*Ticket)
+			r0 = ret.Get(0).(*Locale)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*Ticket) error); ok {
+	if rf, ok := ret.Get(1).(func(string) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -222,22 +4755,22 @@ func (_m *MockClient) CreateTicket(_a0 *Ticket) (*Ticket, error) {
 	return r0, r1
 }
 
-// CreateUser provides a mock function with given fields: _a0
-func (_m *MockClient) CreateUser(_a0 *User) (*User, error) {
-	ret := _m.Called(_a0)
+// ShowLocaleByCodeContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) ShowLocaleByCodeContext(_a0 context.Context, _a1 string) (*Locale, error) {
+	ret := _m.Called(_a0, _a1)
 
-	var r0 *User
-	if rf, ok := ret.Get(0).(func(*User) *User); ok {
-		r0 = rf(_a0)
+	var r0 *Locale
+	if rf, ok := ret.Get(0).(func(context.Context, string) *Locale); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*User)
+			r0 = ret.Get(0).(*Locale)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*User) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -245,77 +4778,90 @@ func (_m *MockClient) CreateUser(_a0 *User) (*User, error) {
 	return r0, r1
 }
 
-// DeleteIdentity provides a mock function with given fields: _a0, _a1
-func (_m *MockClient) DeleteIdentity(_a0 int64, _a1 int64) error {
+// ShowLocaleContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) ShowLocaleContext(_a0 context.Context, _a1 int64) (*Locale, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(int64, int64) error); ok {
+	var r0 *Locale
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *Locale); ok {
 		r0 = rf(_a0, _a1)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Locale)
+		}
 	}
 
-	return r0
-}
-
-// DeleteOrganization provides a mock function with given fields: _a0
-func (_m *MockClient) DeleteOrganization(_a0 int64) error {
-	ret := _m.Called(_a0)
-
-	var r0 error
-	if rf, ok := ret.Get(0).(func(int64) error); ok {
-		r0 = rf(_a0)
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
-		r0 = ret.Error(0)
+		r1 = ret.Error(1)
 	}
 
-	return r0
+	return r0, r1
 }
 
-// DeleteOrganizationMembershipByID provides a mock function with given fields: _a0
-func (_m *MockClient) DeleteOrganizationMembershipByID(_a0 int64) error {
+// ShowManyJobStatuses provides a mock function with given fields: _a0
+func (_m *MockClient) ShowManyJobStatuses(_a0 []string) ([]JobStatus, error) {
 	ret := _m.Called(_a0)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(int64) error); ok {
+	var r0 []JobStatus
+	if rf, ok := ret.Get(0).(func([]string) []JobStatus); ok {
 		r0 = rf(_a0)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]JobStatus)
+		}
 	}
 
-	return r0
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]string) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// DeleteTicket provides a mock function with given fields: _a0
-func (_m *MockClient) DeleteTicket(_a0 int64) error {
-	ret := _m.Called(_a0)
+// ShowManyJobStatusesContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) ShowManyJobStatusesContext(_a0 context.Context, _a1 []string) ([]JobStatus, error) {
+	ret := _m.Called(_a0, _a1)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(int64) error); ok {
-		r0 = rf(_a0)
+	var r0 []JobStatus
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []JobStatus); ok {
+		r0 = rf(_a0, _a1)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]JobStatus)
+		}
 	}
 
-	return r0
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// DeleteUser provides a mock function with given fields: _a0
-func (_m *MockClient) DeleteUser(_a0 int64) (*User, error) {
+// ShowManyOrganizations provides a mock function with given fields: _a0
+func (_m *MockClient) ShowManyOrganizations(_a0 []int64) ([]Organization, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *User
-	if rf, ok := ret.Get(0).(func(int64) *User); ok {
+	var r0 []Organization
+	if rf, ok := ret.Get(0).(func([]int64) []Organization); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*User)
+			r0 = ret.Get(0).([]Organization)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(int64) error); ok {
+	if rf, ok := ret.Get(1).(func([]int64) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -324,22 +4870,22 @@ func (_m *MockClient) DeleteUser(_a0 int64) (*User, error) {
 	return r0, r1
 }
 
-// ListIdentities provides a mock function with given fields: _a0
-func (_m *MockClient) ListIdentities(_a0 int64) ([]UserIdentity, error) {
-	ret := _m.Called(_a0)
+// ShowManyOrganizationsContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) ShowManyOrganizationsContext(_a0 context.Context, _a1 []int64) ([]Organization, error) {
+	ret := _m.Called(_a0, _a1)
 
-	var r0 []UserIdentity
-	if rf, ok := ret.Get(0).(func(int64) []UserIdentity); ok {
-		r0 = rf(_a0)
+	var r0 []Organization
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) []Organization); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]UserIdentity)
+			r0 = ret.Get(0).([]Organization)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(int64) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, []int64) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -347,22 +4893,22 @@ func (_m *MockClient) ListIdentities(_a0 int64) ([]UserIdentity, error) {
 	return r0, r1
 }
 
-// ListLocales provides a mock function with given fields:
-func (_m *MockClient) ListLocales() ([]Locale, error) {
-	ret := _m.Called()
+// ShowManyUsers provides a mock function with given fields: _a0
+func (_m *MockClient) ShowManyUsers(_a0 []int64) ([]User, error) {
+	ret := _m.Called(_a0)
 
-	var r0 []Locale
-	if rf, ok := ret.Get(0).(func() []Locale); ok {
-		r0 = rf()
+	var r0 []User
+	if rf, ok := ret.Get(0).(func([]int64) []User); ok {
+		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]Locale)
+			r0 = ret.Get(0).([]User)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func([]int64) error); ok {
+		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -370,22 +4916,22 @@ func (_m *MockClient) ListLocales() ([]Locale, error) {
 	return r0, r1
 }
 
-// ListOrganizationMembershipsByUserID provides a mock function with given fields: id
-func (_m *MockClient) ListOrganizationMembershipsByUserID(id int64) ([]OrganizationMembership, error) {
-	ret := _m.Called(id)
+// ShowManyUsersByExternalIDs provides a mock function with given fields: _a0
+func (_m *MockClient) ShowManyUsersByExternalIDs(_a0 []string) ([]User, error) {
+	ret := _m.Called(_a0)
 
-	var r0 []OrganizationMembership
-	if rf, ok := ret.Get(0).(func(int64) []OrganizationMembership); ok {
-		r0 = rf(id)
+	var r0 []User
+	if rf, ok := ret.Get(0).(func([]string) []User); ok {
+		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]OrganizationMembership)
+			r0 = ret.Get(0).([]User)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(int64) error); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func([]string) error); ok {
+		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -393,29 +4939,22 @@ func (_m *MockClient) ListOrganizationMembershipsByUserID(id int64) ([]Organizat
 	return r0, r1
 }
 
-// ListOrganizationTickets provides a mock function with given fields: _a0, _a1, _a2
-func (_m *MockClient) ListOrganizationTickets(_a0 int64, _a1 *ListOptions, _a2 ...SideLoad) (*ListResponse, error) {
-	_va := make([]interface{}, len(_a2))
-	for _i := range _a2 {
-		_va[_i] = _a2[_i]
-	}
-	var _ca []interface{}
-	_ca = append(_ca, _a0, _a1)
-	_ca = append(_ca, _va...)
-	ret := _m.Called(_ca...)
+// ShowManyUsersByExternalIDsContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) ShowManyUsersByExternalIDsContext(_a0 context.Context, _a1 []string) ([]User, error) {
+	ret := _m.Called(_a0, _a1)
 
-	var r0 *ListResponse
-	if rf, ok := ret.Get(0).(func(int64, *ListOptions, ...SideLoad) *ListResponse); ok {
-		r0 = rf(_a0, _a1, _a2...)
+	var r0 []User
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []User); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ListResponse)
+			r0 = ret.Get(0).([]User)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(int64, *ListOptions, ...SideLoad) error); ok {
-		r1 = rf(_a0, _a1, _a2...)
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -423,12 +4962,12 @@ func (_m *MockClient) ListOrganizationTickets(_a0 int64, _a1 *ListOptions, _a2 .
 	return r0, r1
 }
 
-// ListOrganizationUsers provides a mock function with given fields: _a0, _a1
-func (_m *MockClient) ListOrganizationUsers(_a0 int64, _a1 *ListUsersOptions) ([]User, error) {
+// ShowManyUsersContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) ShowManyUsersContext(_a0 context.Context, _a1 []int64) ([]User, error) {
 	ret := _m.Called(_a0, _a1)
 
 	var r0 []User
-	if rf, ok := ret.Get(0).(func(int64, *ListUsersOptions) []User); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) []User); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
@@ -437,7 +4976,7 @@ func (_m *MockClient) ListOrganizationUsers(_a0 int64, _a1 *ListUsersOptions) ([
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(int64, *ListUsersOptions) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, []int64) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -446,21 +4985,21 @@ func (_m *MockClient) ListOrganizationUsers(_a0 int64, _a1 *ListUsersOptions) ([
 	return r0, r1
 }
 
-// ListOrganizations provides a mock function with given fields: _a0
-func (_m *MockClient) ListOrganizations(_a0 *ListOptions) ([]Organization, error) {
+// ShowOAuthClient provides a mock function with given fields: _a0
+func (_m *MockClient) ShowOAuthClient(_a0 int64) (*OAuthClient, error) {
 	ret := _m.Called(_a0)
 
-	var r0 []Organization
-	if rf, ok := ret.Get(0).(func(*ListOptions) []Organization); ok {
+	var r0 *OAuthClient
+	if rf, ok := ret.Get(0).(func(int64) *OAuthClient); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]Organization)
+			r0 = ret.Get(0).(*OAuthClient)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*ListOptions) error); ok {
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -469,22 +5008,22 @@ func (_m *MockClient) ListOrganizations(_a0 *ListOptions) ([]Organization, error
 	return r0, r1
 }
 
-// ListRequestedTickets provides a mock function with given fields: _a0
-func (_m *MockClient) ListRequestedTickets(_a0 int64) ([]Ticket, error) {
-	ret := _m.Called(_a0)
+// ShowOAuthClientContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) ShowOAuthClientContext(_a0 context.Context, _a1 int64) (*OAuthClient, error) {
+	ret := _m.Called(_a0, _a1)
 
-	var r0 []Ticket
-	if rf, ok := ret.Get(0).(func(int64) []Ticket); ok {
-		r0 = rf(_a0)
+	var r0 *OAuthClient
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *OAuthClient); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]Ticket)
+			r0 = ret.Get(0).(*OAuthClient)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(int64) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -492,16 +5031,16 @@ func (_m *MockClient) ListRequestedTickets(_a0 int64) ([]Ticket, error) {
 	return r0, r1
 }
 
-// ListTicketComments provides a mock function with given fields: _a0
-func (_m *MockClient) ListTicketComments(_a0 int64) ([]TicketComment, error) {
+// ShowOAuthToken provides a mock function with given fields: _a0
+func (_m *MockClient) ShowOAuthToken(_a0 int64) (*OAuthToken, error) {
 	ret := _m.Called(_a0)
 
-	var r0 []TicketComment
-	if rf, ok := ret.Get(0).(func(int64) []TicketComment); ok {
+	var r0 *OAuthToken
+	if rf, ok := ret.Get(0).(func(int64) *OAuthToken); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]TicketComment)
+			r0 = ret.Get(0).(*OAuthToken)
 		}
 	}
 
@@ -515,22 +5054,22 @@ func (_m *MockClient) ListTicketComments(_a0 int64) ([]TicketComment, error) {
 	return r0, r1
 }
 
-// ListTicketFields provides a mock function with given fields:
-func (_m *MockClient) ListTicketFields() ([]TicketField, error) {
-	ret := _m.Called()
+// ShowOAuthTokenContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) ShowOAuthTokenContext(_a0 context.Context, _a1 int64) (*OAuthToken, error) {
+	ret := _m.Called(_a0, _a1)
 
-	var r0 []TicketField
-	if rf, ok := ret.Get(0).(func() []TicketField); ok {
-		r0 = rf()
+	var r0 *OAuthToken
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *OAuthToken); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]TicketField)
+			r0 = ret.Get(0).(*OAuthToken)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -538,16 +5077,16 @@ func (_m *MockClient) ListTicketFields() ([]TicketField, error) {
 	return r0, r1
 }
 
-// ListTicketIncidents provides a mock function with given fields: _a0
-func (_m *MockClient) ListTicketIncidents(_a0 int64) ([]Ticket, error) {
+// ShowOrganization provides a mock function with given fields: _a0
+func (_m *MockClient) ShowOrganization(_a0 int64) (*Organization, error) {
 	ret := _m.Called(_a0)
 
-	var r0 []Ticket
-	if rf, ok := ret.Get(0).(func(int64) []Ticket); ok {
+	var r0 *Organization
+	if rf, ok := ret.Get(0).(func(int64) *Organization); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]Ticket)
+			r0 = ret.Get(0).(*Organization)
 		}
 	}
 
@@ -561,21 +5100,44 @@ func (_m *MockClient) ListTicketIncidents(_a0 int64) ([]Ticket, error) {
 	return r0, r1
 }
 
-// ListUsers provides a mock function with given fields: _a0
-func (_m *MockClient) ListUsers(_a0 *ListUsersOptions) ([]User, error) {
+// ShowOrganizationContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) ShowOrganizationContext(_a0 context.Context, _a1 int64) (*Organization, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *Organization
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *Organization); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Organization)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ShowTicket provides a mock function with given fields: _a0
+func (_m *MockClient) ShowTicket(_a0 int64) (*Ticket, error) {
 	ret := _m.Called(_a0)
 
-	var r0 []User
-	if rf, ok := ret.Get(0).(func(*ListUsersOptions) []User); ok {
+	var r0 *Ticket
+	if rf, ok := ret.Get(0).(func(int64) *Ticket); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]User)
+			r0 = ret.Get(0).(*Ticket)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*ListUsersOptions) error); ok {
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -584,22 +5146,22 @@ func (_m *MockClient) ListUsers(_a0 *ListUsersOptions) ([]User, error) {
 	return r0, r1
 }
 
-// PermanentlyDeleteTicket provides a mock function with given fields: _a0
-func (_m *MockClient) PermanentlyDeleteTicket(_a0 int64) (*JobStatus, error) {
-	ret := _m.Called(_a0)
+// ShowTicketContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) ShowTicketContext(_a0 context.Context, _a1 int64) (*Ticket, error) {
+	ret := _m.Called(_a0, _a1)
 
-	var r0 *JobStatus
-	if rf, ok := ret.Get(0).(func(int64) *JobStatus); ok {
-		r0 = rf(_a0)
+	var r0 *Ticket
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *Ticket); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*JobStatus)
+			r0 = ret.Get(0).(*Ticket)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(int64) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -607,8 +5169,8 @@ func (_m *MockClient) PermanentlyDeleteTicket(_a0 int64) (*JobStatus, error) {
 	return r0, r1
 }
 
-// PermanentlyDeleteUser provides a mock function with given fields: _a0
-func (_m *MockClient) PermanentlyDeleteUser(_a0 int64) (*User, error) {
+// ShowUser provides a mock function with given fields: _a0
+func (_m *MockClient) ShowUser(_a0 int64) (*User, error) {
 	ret := _m.Called(_a0)
 
 	var r0 *User
@@ -630,22 +5192,22 @@ func (_m *MockClient) PermanentlyDeleteUser(_a0 int64) (*User, error) {
 	return r0, r1
 }
 
-// RedactCommentString provides a mock function with given fields: _a0, _a1, _a2
-func (_m *MockClient) RedactCommentString(_a0 int64, _a1 int64, _a2 string) (*TicketComment, error) {
-	ret := _m.Called(_a0, _a1, _a2)
+// ShowUserContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) ShowUserContext(_a0 context.Context, _a1 int64) (*User, error) {
+	ret := _m.Called(_a0, _a1)
 
-	var r0 *TicketComment
-	if rf, ok := ret.Get(0).(func(int64, int64, string) *TicketComment); ok {
-		r0 = rf(_a0, _a1, _a2)
+	var r0 *User
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *User); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*TicketComment)
+			r0 = ret.Get(0).(*User)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(int64, int64, string) error); ok {
-		r1 = rf(_a0, _a1, _a2)
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -653,22 +5215,22 @@ func (_m *MockClient) RedactCommentString(_a0 int64, _a1 int64, _a2 string) (*Ti
 	return r0, r1
 }
 
-// SearchOrganizationsByExternalID provides a mock function with given fields: _a0
-func (_m *MockClient) SearchOrganizationsByExternalID(_a0 string) ([]Organization, error) {
-	ret := _m.Called(_a0)
+// UpdateGroup provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) UpdateGroup(_a0 int64, _a1 *Group) (*Group, error) {
+	ret := _m.Called(_a0, _a1)
 
-	var r0 []Organization
-	if rf, ok := ret.Get(0).(func(string) []Organization); ok {
-		r0 = rf(_a0)
+	var r0 *Group
+	if rf, ok := ret.Get(0).(func(int64, *Group) *Group); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]Organization)
+			r0 = ret.Get(0).(*Group)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(int64, *Group) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -676,29 +5238,22 @@ func (_m *MockClient) SearchOrganizationsByExternalID(_a0 string) ([]Organizatio
 	return r0, r1
 }
 
-// SearchTickets provides a mock function with given fields: _a0, _a1, _a2
-func (_m *MockClient) SearchTickets(_a0 string, _a1 *ListOptions, _a2 ...Filters) (*TicketSearchResults, error) {
-	_va := make([]interface{}, len(_a2))
-	for _i := range _a2 {
-		_va[_i] = _a2[_i]
-	}
-	var _ca []interface{}
-	_ca = append(_ca, _a0, _a1)
-	_ca = append(_ca, _va...)
-	ret := _m.Called(_ca...)
+// UpdateGroupContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockClient) UpdateGroupContext(_a0 context.Context, _a1 int64, _a2 *Group) (*Group, error) {
+	ret := _m.Called(_a0, _a1, _a2)
 
-	var r0 *TicketSearchResults
-	if rf, ok := ret.Get(0).(func(string, *ListOptions, ...Filters) *TicketSearchResults); ok {
-		r0 = rf(_a0, _a1, _a2...)
+	var r0 *Group
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *Group) *Group); ok {
+		r0 = rf(_a0, _a1, _a2)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*TicketSearchResults)
+			r0 = ret.Get(0).(*Group)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, *ListOptions, ...Filters) error); ok {
-		r1 = rf(_a0, _a1, _a2...)
+	if rf, ok := ret.Get(1).(func(context.Context, int64, *Group) error); ok {
+		r1 = rf(_a0, _a1, _a2)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -706,22 +5261,22 @@ func (_m *MockClient) SearchTickets(_a0 string, _a1 *ListOptions, _a2 ...Filters
 	return r0, r1
 }
 
-// SearchUserByExternalID provides a mock function with given fields: _a0
-func (_m *MockClient) SearchUserByExternalID(_a0 string) (*User, error) {
-	ret := _m.Called(_a0)
+// UpdateIdentity provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockClient) UpdateIdentity(_a0 int64, _a1 int64, _a2 *UserIdentity) (*UserIdentity, error) {
+	ret := _m.Called(_a0, _a1, _a2)
 
-	var r0 *User
-	if rf, ok := ret.Get(0).(func(string) *User); ok {
-		r0 = rf(_a0)
+	var r0 *UserIdentity
+	if rf, ok := ret.Get(0).(func(int64, int64, *UserIdentity) *UserIdentity); ok {
+		r0 = rf(_a0, _a1, _a2)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*User)
+			r0 = ret.Get(0).(*UserIdentity)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(int64, int64, *UserIdentity) error); ok {
+		r1 = rf(_a0, _a1, _a2)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -729,22 +5284,22 @@ func (_m *MockClient) SearchUserByExternalID(_a0 string) (*User, error) {
 	return r0, r1
 }
 
-// SearchUsers provides a mock function with given fields: _a0
-func (_m *MockClient) SearchUsers(_a0 string) ([]User, error) {
-	ret := _m.Called(_a0)
+// UpdateIdentityContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *MockClient) UpdateIdentityContext(_a0 context.Context, _a1 int64, _a2 int64, _a3 *UserIdentity) (*UserIdentity, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
 
-	var r0 []User
-	if rf, ok := ret.Get(0).(func(string) []User); ok {
-		r0 = rf(_a0)
+	var r0 *UserIdentity
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, *UserIdentity) *UserIdentity); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]User)
+			r0 = ret.Get(0).(*UserIdentity)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, *UserIdentity) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -752,21 +5307,21 @@ func (_m *MockClient) SearchUsers(_a0 string) ([]User, error) {
 	return r0, r1
 }
 
-// ShowComplianceDeletionStatuses provides a mock function with given fields: _a0
-func (_m *MockClient) ShowComplianceDeletionStatuses(_a0 int64) ([]ComplianceDeletionStatus, error) {
+// UpdateManyOrganizations provides a mock function with given fields: _a0
+func (_m *MockClient) UpdateManyOrganizations(_a0 []Organization) ([]JobStatus, error) {
 	ret := _m.Called(_a0)
 
-	var r0 []ComplianceDeletionStatus
-	if rf, ok := ret.Get(0).(func(int64) []ComplianceDeletionStatus); ok {
+	var r0 []JobStatus
+	if rf, ok := ret.Get(0).(func([]Organization) []JobStatus); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]ComplianceDeletionStatus)
+			r0 = ret.Get(0).([]JobStatus)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(int64) error); ok {
+	if rf, ok := ret.Get(1).(func([]Organization) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -775,21 +5330,21 @@ func (_m *MockClient) ShowComplianceDeletionStatuses(_a0 int64) ([]ComplianceDel
 	return r0, r1
 }
 
-// ShowIdentity provides a mock function with given fields: _a0, _a1
-func (_m *MockClient) ShowIdentity(_a0 int64, _a1 int64) (*UserIdentity, error) {
+// UpdateManyOrganizationsContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) UpdateManyOrganizationsContext(_a0 context.Context, _a1 []Organization) ([]JobStatus, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *UserIdentity
-	if rf, ok := ret.Get(0).(func(int64, int64) *UserIdentity); ok {
+	var r0 []JobStatus
+	if rf, ok := ret.Get(0).(func(context.Context, []Organization) []JobStatus); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*UserIdentity)
+			r0 = ret.Get(0).([]JobStatus)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(int64, int64) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, []Organization) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -798,21 +5353,21 @@ func (_m *MockClient) ShowIdentity(_a0 int64, _a1 int64) (*UserIdentity, error)
 	return r0, r1
 }
 
-// ShowJobStatus provides a mock function with given fields: _a0
-func (_m *MockClient) ShowJobStatus(_a0 string) (*JobStatus, error) {
+// UpdateManyTickets provides a mock function with given fields: _a0
+func (_m *MockClient) UpdateManyTickets(_a0 []Ticket) ([]JobStatus, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *JobStatus
-	if rf, ok := ret.Get(0).(func(string) *JobStatus); ok {
+	var r0 []JobStatus
+	if rf, ok := ret.Get(0).(func([]Ticket) []JobStatus); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*JobStatus)
+			r0 = ret.Get(0).([]JobStatus)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string) error); ok {
+	if rf, ok := ret.Get(1).(func([]Ticket) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -821,22 +5376,22 @@ func (_m *MockClient) ShowJobStatus(_a0 string) (*JobStatus, error) {
 	return r0, r1
 }
 
-// ShowLocale provides a mock function with given fields: _a0
-func (_m *MockClient) ShowLocale(_a0 int64) (*Locale, error) {
-	ret := _m.Called(_a0)
+// UpdateManyTicketsContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) UpdateManyTicketsContext(_a0 context.Context, _a1 []Ticket) ([]JobStatus, error) {
+	ret := _m.Called(_a0, _a1)
 
-	var r0 *Locale
-	if rf, ok := ret.Get(0).(func(int64) *Locale); ok {
-		r0 = rf(_a0)
+	var r0 []JobStatus
+	if rf, ok := ret.Get(0).(func(context.Context, []Ticket) []JobStatus); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*Locale)
+			r0 = ret.Get(0).([]JobStatus)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(int64) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, []Ticket) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -844,21 +5399,21 @@ func (_m *MockClient) ShowLocale(_a0 int64) (*Locale, error) {
 	return r0, r1
 }
 
-// ShowLocaleByCode provides a mock function with given fields: _a0
-func (_m *MockClient) ShowLocaleByCode(_a0 string) (*Locale, error) {
+// UpdateManyUsers provides a mock function with given fields: _a0
+func (_m *MockClient) UpdateManyUsers(_a0 []User) ([]JobStatus, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *Locale
-	if rf, ok := ret.Get(0).(func(string) *Locale); ok {
+	var r0 []JobStatus
+	if rf, ok := ret.Get(0).(func([]User) []JobStatus); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*Locale)
+			r0 = ret.Get(0).([]JobStatus)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string) error); ok {
+	if rf, ok := ret.Get(1).(func([]User) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -867,22 +5422,22 @@ func (_m *MockClient) ShowLocaleByCode(_a0 string) (*Locale, error) {
 	return r0, r1
 }
 
-// ShowManyUsers provides a mock function with given fields: _a0
-func (_m *MockClient) ShowManyUsers(_a0 []int64) ([]User, error) {
-	ret := _m.Called(_a0)
+// UpdateManyUsersContext provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) UpdateManyUsersContext(_a0 context.Context, _a1 []User) ([]JobStatus, error) {
+	ret := _m.Called(_a0, _a1)
 
-	var r0 []User
-	if rf, ok := ret.Get(0).(func([]int64) []User); ok {
-		r0 = rf(_a0)
+	var r0 []JobStatus
+	if rf, ok := ret.Get(0).(func(context.Context, []User) []JobStatus); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]User)
+			r0 = ret.Get(0).([]JobStatus)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func([]int64) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, []User) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -890,22 +5445,22 @@ func (_m *MockClient) ShowManyUsers(_a0 []int64) ([]User, error) {
 	return r0, r1
 }
 
-// ShowOrganization provides a mock function with given fields: _a0
-func (_m *MockClient) ShowOrganization(_a0 int64) (*Organization, error) {
-	ret := _m.Called(_a0)
+// UpdateOAuthClient provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) UpdateOAuthClient(_a0 int64, _a1 *OAuthClient) (*OAuthClient, error) {
+	ret := _m.Called(_a0, _a1)
 
-	var r0 *Organization
-	if rf, ok := ret.Get(0).(func(int64) *Organization); ok {
-		r0 = rf(_a0)
+	var r0 *OAuthClient
+	if rf, ok := ret.Get(0).(func(int64, *OAuthClient) *OAuthClient); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*Organization)
+			r0 = ret.Get(0).(*OAuthClient)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(int64) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(int64, *OAuthClient) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -913,22 +5468,22 @@ func (_m *MockClient) ShowOrganization(_a0 int64) (*Organization, error) {
 	return r0, r1
 }
 
-// ShowTicket provides a mock function with given fields: _a0
-func (_m *MockClient) ShowTicket(_a0 int64) (*Ticket, error) {
-	ret := _m.Called(_a0)
+// UpdateOAuthClientContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockClient) UpdateOAuthClientContext(_a0 context.Context, _a1 int64, _a2 *OAuthClient) (*OAuthClient, error) {
+	ret := _m.Called(_a0, _a1, _a2)
 
-	var r0 *Ticket
-	if rf, ok := ret.Get(0).(func(int64) *Ticket); ok {
-		r0 = rf(_a0)
+	var r0 *OAuthClient
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *OAuthClient) *OAuthClient); ok {
+		r0 = rf(_a0, _a1, _a2)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*Ticket)
+			r0 = ret.Get(0).(*OAuthClient)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(int64) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, int64, *OAuthClient) error); ok {
+		r1 = rf(_a0, _a1, _a2)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -936,22 +5491,22 @@ func (_m *MockClient) ShowTicket(_a0 int64) (*Ticket, error) {
 	return r0, r1
 }
 
-// ShowUser provides a mock function with given fields: _a0
-func (_m *MockClient) ShowUser(_a0 int64) (*User, error) {
-	ret := _m.Called(_a0)
+// UpdateOrganization provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) UpdateOrganization(_a0 int64, _a1 *Organization) (*Organization, error) {
+	ret := _m.Called(_a0, _a1)
 
-	var r0 *User
-	if rf, ok := ret.Get(0).(func(int64) *User); ok {
-		r0 = rf(_a0)
+	var r0 *Organization
+	if rf, ok := ret.Get(0).(func(int64, *Organization) *Organization); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*User)
+			r0 = ret.Get(0).(*Organization)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(int64) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(int64, *Organization) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -959,21 +5514,21 @@ func (_m *MockClient) ShowUser(_a0 int64) (*User, error) {
 	return r0, r1
 }
 
-// UpdateIdentity provides a mock function with given fields: _a0, _a1, _a2
-func (_m *MockClient) UpdateIdentity(_a0 int64, _a1 int64, _a2 *UserIdentity) (*UserIdentity, error) {
+// UpdateOrganizationContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockClient) UpdateOrganizationContext(_a0 context.Context, _a1 int64, _a2 *Organization) (*Organization, error) {
 	ret := _m.Called(_a0, _a1, _a2)
 
-	var r0 *UserIdentity
-	if rf, ok := ret.Get(0).(func(int64, int64, *UserIdentity) *UserIdentity); ok {
+	var r0 *Organization
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *Organization) *Organization); ok {
 		r0 = rf(_a0, _a1, _a2)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*UserIdentity)
+			r0 = ret.Get(0).(*Organization)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(int64, int64, *UserIdentity) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, int64, *Organization) error); ok {
 		r1 = rf(_a0, _a1, _a2)
 	} else {
 		r1 = ret.Error(1)
@@ -982,21 +5537,21 @@ func (_m *MockClient) UpdateIdentity(_a0 int64, _a1 int64, _a2 *UserIdentity) (*
 	return r0, r1
 }
 
-// UpdateOrganization provides a mock function with given fields: _a0, _a1
-func (_m *MockClient) UpdateOrganization(_a0 int64, _a1 *Organization) (*Organization, error) {
+// UpdateTicket provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) UpdateTicket(_a0 int64, _a1 *Ticket) (*Ticket, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *Organization
-	if rf, ok := ret.Get(0).(func(int64, *Organization) *Organization); ok {
+	var r0 *Ticket
+	if rf, ok := ret.Get(0).(func(int64, *Ticket) *Ticket); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*Organization)
+			r0 = ret.Get(0).(*Ticket)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(int64, *Organization) error); ok {
+	if rf, ok := ret.Get(1).(func(int64, *Ticket) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -1005,13 +5560,13 @@ func (_m *MockClient) UpdateOrganization(_a0 int64, _a1 *Organization) (*Organiz
 	return r0, r1
 }
 
-// UpdateTicket provides a mock function with given fields: _a0, _a1
-func (_m *MockClient) UpdateTicket(_a0 int64, _a1 *Ticket) (*Ticket, error) {
-	ret := _m.Called(_a0, _a1)
+// UpdateTicketContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockClient) UpdateTicketContext(_a0 context.Context, _a1 int64, _a2 *Ticket) (*Ticket, error) {
+	ret := _m.Called(_a0, _a1, _a2)
 
 	var r0 *Ticket
-	if rf, ok := ret.Get(0).(func(int64, *Ticket) *Ticket); ok {
-		r0 = rf(_a0, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *Ticket) *Ticket); ok {
+		r0 = rf(_a0, _a1, _a2)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*Ticket)
@@ -1019,8 +5574,8 @@ func (_m *MockClient) UpdateTicket(_a0 int64, _a1 *Ticket) (*Ticket, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(int64, *Ticket) error); ok {
-		r1 = rf(_a0, _a1)
+	if rf, ok := ret.Get(1).(func(context.Context, int64, *Ticket) error); ok {
+		r1 = rf(_a0, _a1, _a2)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1051,6 +5606,29 @@ func (_m *MockClient) UpdateUser(_a0 int64, _a1 *User) (*User, error) {
 	return r0, r1
 }
 
+// UpdateUserContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockClient) UpdateUserContext(_a0 context.Context, _a1 int64, _a2 *User) (*User, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *User
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *User) *User); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*User)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, *User) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UploadFile provides a mock function with given fields: _a0, _a1, _a2
 func (_m *MockClient) UploadFile(_a0 string, _a1 *string, _a2 io.Reader) (*Upload, error) {
 	ret := _m.Called(_a0, _a1, _a2)
@@ -1074,6 +5652,52 @@ func (_m *MockClient) UploadFile(_a0 string, _a1 *string, _a2 io.Reader) (*Uploa
 	return r0, r1
 }
 
+// UploadFileContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *MockClient) UploadFileContext(_a0 context.Context, _a1 string, _a2 *string, _a3 io.Reader) (*Upload, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	var r0 *Upload
+	if rf, ok := ret.Get(0).(func(context.Context, string, *string, io.Reader) *Upload); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Upload)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, *string, io.Reader) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WaitForJob provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockClient) WaitForJob(_a0 context.Context, _a1 string, _a2 time.Duration) (*JobStatus, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *JobStatus
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Duration) *JobStatus); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*JobStatus)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Duration) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // WithHeader provides a mock function with given fields: name, value
 func (_m *MockClient) WithHeader(name string, value string) Client {
 	ret := _m.Called(name, value)
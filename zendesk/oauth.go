@@ -0,0 +1,153 @@
+package zendesk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// ErrOAuthStateMismatch is reported by the OAuth redirect handler when the state
+// parameter of the redirect doesn't match the expected one.
+var ErrOAuthStateMismatch = errors.New("zendesk: oauth state mismatch")
+
+// OAuthConfig describes an OAuth client registered in Zendesk, used to run the
+// authorization code flow.
+//
+// Zendesk Core API docs: https://support.zendesk.com/hc/en-us/articles/203663836
+type OAuthConfig struct {
+	// Endpoint is the URL of the Zendesk instance, e.g. https://domain.zendesk.com.
+	Endpoint string
+	// ClientID is the unique identifier of the OAuth client.
+	ClientID string
+	// ClientSecret is the secret of the OAuth client.
+	ClientSecret string
+	// RedirectURL is the URL Zendesk redirects the user to after the authorization.
+	RedirectURL string
+	// Scopes lists the requested scopes, e.g. read or write.
+	Scopes []string
+	// HTTPClient is used to exchange authorization codes. Defaults to http.DefaultClient.
+	HTTPClient *http.Client
+}
+
+// OAuthAccessToken represents the access token granted at the end of the authorization code flow.
+type OAuthAccessToken struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	Scope        string `json:"scope"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	ExpiresIn    int64  `json:"expires_in,omitempty"`
+}
+
+// OAuthError represents an error reported by Zendesk to the redirect URL or by
+// the token endpoint.
+type OAuthError struct {
+	Code        string
+	Description string
+}
+
+func (e *OAuthError) Error() string {
+	if e.Description == "" {
+		return "zendesk: oauth: " + e.Code
+	}
+	return fmt.Sprintf("zendesk: oauth: %s: %s", e.Code, e.Description)
+}
+
+// AuthCodeURL returns the URL of the page where users authorize the OAuth client.
+// The state is sent back to the redirect URL and should be checked by the caller
+// to protect against CSRF attacks.
+func (c *OAuthConfig) AuthCodeURL(state string) string {
+	params := url.Values{}
+	params.Set("response_type", "code")
+	params.Set("client_id", c.ClientID)
+	params.Set("redirect_uri", c.RedirectURL)
+	params.Set("scope", strings.Join(c.Scopes, " "))
+	if state != "" {
+		params.Set("state", state)
+	}
+
+	return strings.TrimSuffix(c.Endpoint, "/") + "/oauth/authorizations/new?" + params.Encode()
+}
+
+// RedirectHandler returns an http.Handler to serve on the redirect URL. It checks
+// the state of each redirect and passes the authorization code, or the error
+// reported by Zendesk, to callback.
+func (c *OAuthConfig) RedirectHandler(state string, callback func(code string, err error)) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		params := r.URL.Query()
+
+		if code := params.Get("error"); code != "" {
+			http.Error(w, "Authorization failed.", http.StatusBadRequest)
+			callback("", &OAuthError{Code: code, Description: params.Get("error_description")})
+			return
+		}
+
+		if params.Get("state") != state {
+			http.Error(w, "Authorization failed.", http.StatusBadRequest)
+			callback("", ErrOAuthStateMismatch)
+			return
+		}
+
+		code := params.Get("code")
+		if code == "" {
+			http.Error(w, "Authorization failed.", http.StatusBadRequest)
+			callback("", &OAuthError{Code: "missing_code"})
+			return
+		}
+
+		fmt.Fprintln(w, "Authorization complete, you may close this window.")
+		callback(code, nil)
+	})
+}
+
+// Exchange converts an authorization code into an access token.
+//
+// Zendesk Core API docs: https://support.zendesk.com/hc/en-us/articles/203663836#topic_ar1_hnh_gl
+func (c *OAuthConfig) Exchange(ctx context.Context, code string) (*OAuthAccessToken, error) {
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	cl, err := newClient(c.Endpoint, "", "", WithHTTPClient(httpClient), withoutAuth())
+	if err != nil {
+		return nil, err
+	}
+
+	in := map[string]string{
+		"grant_type":    "authorization_code",
+		"code":          code,
+		"client_id":     c.ClientID,
+		"client_secret": c.ClientSecret,
+		"redirect_uri":  c.RedirectURL,
+		"scope":         strings.Join(c.Scopes, " "),
+	}
+	out := new(OAuthAccessToken)
+	if err := cl.post(ctx, "Exchange", routef("/oauth/tokens"), in, out); err != nil {
+		return nil, oauthError(err)
+	}
+	return out, nil
+}
+
+// oauthError converts the API errors of the token endpoint, which follow RFC 6749
+// rather than the shape of the other API errors, into an OAuthError.
+func oauthError(err error) error {
+	var apierr *APIError
+	if !errors.As(err, &apierr) || apierr.Type == nil {
+		return err
+	}
+
+	oauthErr := &OAuthError{Code: *apierr.Type}
+	if apierr.Description != nil {
+		oauthErr.Description = *apierr.Description
+	}
+	return oauthErr
+}
+
+// NewClient creates a Client for the Zendesk instance of the config that is
+// authenticated with the given access token.
+func (c *OAuthConfig) NewClient(token *OAuthAccessToken, opts ...ClientOption) (Client, error) {
+	return NewURLClient(c.Endpoint, "", "", append([]ClientOption{WithOAuthToken(token.AccessToken)}, opts...)...)
+}
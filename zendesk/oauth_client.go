@@ -0,0 +1,95 @@
+package zendesk
+
+import (
+	"context"
+	"time"
+)
+
+// OAuthClient represents a Zendesk OAuth client.
+//
+// Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/support/oauth_clients
+type OAuthClient struct {
+	ID           *int64     `json:"id,omitempty"`
+	URL          *string    `json:"url,omitempty"`
+	Name         *string    `json:"name,omitempty"`
+	Identifier   *string    `json:"identifier,omitempty"`
+	Company      *string    `json:"company,omitempty"`
+	Description  *string    `json:"description,omitempty"`
+	Kind         *string    `json:"kind,omitempty"`
+	LogoURL      *string    `json:"logo_url,omitempty"`
+	RedirectURIs []string   `json:"redirect_uri,omitempty"`
+	Secret       *string    `json:"secret,omitempty"`
+	UserID       *int64     `json:"user_id,omitempty"`
+	CreatedAt    *time.Time `json:"created_at,omitempty"`
+	UpdatedAt    *time.Time `json:"updated_at,omitempty"`
+}
+
+// ListOAuthClients lists all OAuth clients.
+//
+// Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/support/oauth_clients#list-clients
+func (c *client) ListOAuthClients() ([]OAuthClient, error) {
+	return c.ListOAuthClientsContext(context.Background())
+}
+
+// ListOAuthClientsContext is like ListOAuthClients but uses ctx for the underlying request.
+func (c *client) ListOAuthClientsContext(ctx context.Context) ([]OAuthClient, error) {
+	out := new(APIPayload)
+	err := c.get(ctx, "ListOAuthClients", routef("/api/v2/oauth/clients.json"), out)
+	return out.OAuthClients, err
+}
+
+// ShowOAuthClient fetches an OAuth client by its ID.
+//
+// Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/support/oauth_clients#show-client
+func (c *client) ShowOAuthClient(id int64) (*OAuthClient, error) {
+	return c.ShowOAuthClientContext(context.Background(), id)
+}
+
+// ShowOAuthClientContext is like ShowOAuthClient but uses ctx for the underlying request.
+func (c *client) ShowOAuthClientContext(ctx context.Context, id int64) (*OAuthClient, error) {
+	out := new(APIPayload)
+	err := c.get(ctx, "ShowOAuthClient", routef("/api/v2/oauth/clients/%d.json", id), out)
+	return out.OAuthClient, err
+}
+
+// CreateOAuthClient creates an OAuth client.
+//
+// Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/support/oauth_clients#create-client
+func (c *client) CreateOAuthClient(oauthClient *OAuthClient) (*OAuthClient, error) {
+	return c.CreateOAuthClientContext(context.Background(), oauthClient)
+}
+
+// CreateOAuthClientContext is like CreateOAuthClient but uses ctx for the underlying request.
+func (c *client) CreateOAuthClientContext(ctx context.Context, oauthClient *OAuthClient) (*OAuthClient, error) {
+	in := &APIPayload{OAuthClient: oauthClient}
+	out := new(APIPayload)
+	err := c.post(ctx, "CreateOAuthClient", routef("/api/v2/oauth/clients.json"), in, out)
+	return out.OAuthClient, err
+}
+
+// UpdateOAuthClient updates an OAuth client.
+//
+// Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/support/oauth_clients#update-client
+func (c *client) UpdateOAuthClient(id int64, oauthClient *OAuthClient) (*OAuthClient, error) {
+	return c.UpdateOAuthClientContext(context.Background(), id, oauthClient)
+}
+
+// UpdateOAuthClientContext is like UpdateOAuthClient but uses ctx for the underlying request.
+func (c *client) UpdateOAuthClientContext(ctx context.Context, id int64, oauthClient *OAuthClient) (*OAuthClient, error) {
+	in := &APIPayload{OAuthClient: oauthClient}
+	out := new(APIPayload)
+	err := c.put(ctx, "UpdateOAuthClient", routef("/api/v2/oauth/clients/%d.json", id), in, out)
+	return out.OAuthClient, err
+}
+
+// DeleteOAuthClient deletes an OAuth client.
+//
+// Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/support/oauth_clients#delete-client
+func (c *client) DeleteOAuthClient(id int64) error {
+	return c.DeleteOAuthClientContext(context.Background(), id)
+}
+
+// DeleteOAuthClientContext is like DeleteOAuthClient but uses ctx for the underlying request.
+func (c *client) DeleteOAuthClientContext(ctx context.Context, id int64) error {
+	return c.delete(ctx, "DeleteOAuthClient", routef("/api/v2/oauth/clients/%d.json", id), nil)
+}
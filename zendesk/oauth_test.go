@@ -0,0 +1,133 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOAuthAuthorizationCodeFlow(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/oauth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		var in map[string]string
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&in))
+		require.Equal(t, "authorization_code", in["grant_type"])
+		require.Equal(t, "the-code", in["code"])
+		require.Equal(t, "secret", in["client_secret"])
+		require.Empty(t, r.Header.Get("Authorization"))
+		w.Write([]byte(`{"access_token":"the-token","token_type":"bearer","scope":"read"}`))
+	})
+	mux.HandleFunc("/api/v2/locales/1.json", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "Bearer the-token", r.Header.Get("Authorization"))
+		w.Write([]byte(`{"locale":{"id":1}}`))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	config := &OAuthConfig{
+		Endpoint:     server.URL,
+		ClientID:     "my-client",
+		ClientSecret: "secret",
+		RedirectURL:  "http://localhost:8080/callback",
+		Scopes:       []string{"read"},
+	}
+
+	authURL, err := url.Parse(config.AuthCodeURL("xyz"))
+	require.NoError(t, err)
+	require.Equal(t, "/oauth/authorizations/new", authURL.Path)
+	require.Equal(t, "my-client", authURL.Query().Get("client_id"))
+	require.Equal(t, "code", authURL.Query().Get("response_type"))
+	require.Equal(t, "xyz", authURL.Query().Get("state"))
+
+	var code string
+	var cbErr error
+	handler := config.RedirectHandler("xyz", func(c string, err error) {
+		code, cbErr = c, err
+	})
+
+	// assert that it rejects a redirect with a wrong state
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/callback?code=the-code&state=abc", nil))
+	require.Equal(t, ErrOAuthStateMismatch, cbErr)
+
+	// assert that it reports errors sent by Zendesk
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/callback?error=access_denied&state=xyz", nil))
+	require.Equal(t, &OAuthError{Code: "access_denied"}, cbErr)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", "/callback?code=the-code&state=xyz", nil))
+	require.NoError(t, cbErr)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "the-code", code)
+
+	token, err := config.Exchange(context.Background(), code)
+	require.NoError(t, err)
+	require.Equal(t, "the-token", token.AccessToken)
+
+	client, err := config.NewClient(token)
+	require.NoError(t, err)
+
+	locale, err := client.ShowLocale(1)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), *locale.ID)
+}
+
+func TestOAuthExchangeError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"error":"invalid_grant","error_description":"The provided authorization grant is invalid."}`))
+	}))
+	defer server.Close()
+
+	config := &OAuthConfig{Endpoint: server.URL, ClientID: "my-client", ClientSecret: "secret"}
+
+	_, err := config.Exchange(context.Background(), "expired-code")
+	require.Equal(t, &OAuthError{Code: "invalid_grant", Description: "The provided authorization grant is invalid."}, err)
+}
+
+func TestOAuthClientCRUD(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode.")
+	}
+
+	client, err := NewEnvClient()
+	require.NoError(t, err)
+
+	oauthClient := &OAuthClient{
+		Name:       String("Go Zendesk Test " + randString(8)),
+		Identifier: String("go_zendesk_test_" + randString(8)),
+	}
+
+	created, err := client.CreateOAuthClient(oauthClient)
+	require.NoError(t, err)
+	require.NotNil(t, created.ID)
+	defer client.DeleteOAuthClient(*created.ID)
+
+	found, err := client.ShowOAuthClient(*created.ID)
+	require.NoError(t, err)
+	require.Equal(t, *oauthClient.Identifier, *found.Identifier)
+
+	updated, err := client.UpdateOAuthClient(*created.ID, &OAuthClient{Description: String("updated")})
+	require.NoError(t, err)
+	require.Equal(t, "updated", *updated.Description)
+
+	token, err := client.CreateOAuthToken(&OAuthToken{ClientID: created.ID, Scopes: []string{"read"}})
+	require.NoError(t, err)
+	require.NotNil(t, token.FullToken)
+
+	tokens, err := client.ListOAuthTokens(&ListOAuthTokensOptions{All: true})
+	require.NoError(t, err)
+	require.NotEmpty(t, tokens)
+
+	err = client.RevokeOAuthToken(*token.ID)
+	require.NoError(t, err)
+
+	clients, err := client.ListOAuthClients()
+	require.NoError(t, err)
+	require.NotEmpty(t, clients)
+}
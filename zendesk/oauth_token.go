@@ -0,0 +1,94 @@
+package zendesk
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-querystring/query"
+)
+
+// OAuthToken represents an OAuth access token issued by Zendesk.
+//
+// Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/support/oauth_tokens
+type OAuthToken struct {
+	ID           *int64     `json:"id,omitempty"`
+	URL          *string    `json:"url,omitempty"`
+	UserID       *int64     `json:"user_id,omitempty"`
+	ClientID     *int64     `json:"client_id,omitempty"`
+	Token        *string    `json:"token,omitempty"`
+	FullToken    *string    `json:"full_token,omitempty"`
+	RefreshToken *string    `json:"refresh_token,omitempty"`
+	Scopes       []string   `json:"scopes,omitempty"`
+	CreatedAt    *time.Time `json:"created_at,omitempty"`
+	ExpiresAt    *time.Time `json:"expires_at,omitempty"`
+	UsedAt       *time.Time `json:"used_at,omitempty"`
+}
+
+// ListOAuthTokensOptions specifies the optional parameters for the list OAuth tokens methods.
+type ListOAuthTokensOptions struct {
+	// All lists the tokens of every user of the account instead of only the
+	// tokens of the authenticated user. It requires an admin.
+	All bool `url:"all,omitempty"`
+}
+
+// ListOAuthTokens lists the OAuth tokens of the authenticated user, or of the
+// whole account when opts.All is set.
+//
+// Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/support/oauth_tokens#list-tokens
+func (c *client) ListOAuthTokens(opts *ListOAuthTokensOptions) ([]OAuthToken, error) {
+	return c.ListOAuthTokensContext(context.Background(), opts)
+}
+
+// ListOAuthTokensContext is like ListOAuthTokens but uses ctx for the underlying request.
+func (c *client) ListOAuthTokensContext(ctx context.Context, opts *ListOAuthTokensOptions) ([]OAuthToken, error) {
+	params, err := query.Values(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	out := new(APIPayload)
+	err = c.get(ctx, "ListOAuthTokens", routef("/api/v2/oauth/tokens.json?%s", params.Encode()), out)
+	return out.OAuthTokens, err
+}
+
+// ShowOAuthToken fetches an OAuth token by its ID.
+//
+// Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/support/oauth_tokens#show-token
+func (c *client) ShowOAuthToken(id int64) (*OAuthToken, error) {
+	return c.ShowOAuthTokenContext(context.Background(), id)
+}
+
+// ShowOAuthTokenContext is like ShowOAuthToken but uses ctx for the underlying request.
+func (c *client) ShowOAuthTokenContext(ctx context.Context, id int64) (*OAuthToken, error) {
+	out := new(APIPayload)
+	err := c.get(ctx, "ShowOAuthToken", routef("/api/v2/oauth/tokens/%d.json", id), out)
+	return out.OAuthToken, err
+}
+
+// CreateOAuthToken creates an OAuth token for the given client. The complete
+// token is only returned in the FullToken field of the created token.
+//
+// Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/support/oauth_tokens#create-token
+func (c *client) CreateOAuthToken(token *OAuthToken) (*OAuthToken, error) {
+	return c.CreateOAuthTokenContext(context.Background(), token)
+}
+
+// CreateOAuthTokenContext is like CreateOAuthToken but uses ctx for the underlying request.
+func (c *client) CreateOAuthTokenContext(ctx context.Context, token *OAuthToken) (*OAuthToken, error) {
+	in := &APIPayload{OAuthToken: token}
+	out := new(APIPayload)
+	err := c.post(ctx, "CreateOAuthToken", routef("/api/v2/oauth/tokens.json"), in, out)
+	return out.OAuthToken, err
+}
+
+// RevokeOAuthToken revokes an OAuth token.
+//
+// Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/support/oauth_tokens#revoke-token
+func (c *client) RevokeOAuthToken(id int64) error {
+	return c.RevokeOAuthTokenContext(context.Background(), id)
+}
+
+// RevokeOAuthTokenContext is like RevokeOAuthToken but uses ctx for the underlying request.
+func (c *client) RevokeOAuthTokenContext(ctx context.Context, id int64) error {
+	return c.delete(ctx, "RevokeOAuthToken", routef("/api/v2/oauth/tokens/%d.json", id), nil)
+}
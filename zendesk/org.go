@@ -1,13 +1,11 @@
 package zendesk
 
 import (
-	"fmt"
+	"context"
 	"net/url"
 	"strconv"
 	"strings"
 	"time"
-
-	"github.com/google/go-querystring/query"
 )
 
 // Organization represents a Zendesk organization.
@@ -34,32 +32,60 @@ type Organization struct {
 //
 // Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/core/organizations#show-organization
 func (c *client) ShowOrganization(id int64) (*Organization, error) {
+	return c.ShowOrganizationContext(context.Background(), id)
+}
+
+// ShowOrganizationContext is like ShowOrganization but uses ctx for the underlying request.
+func (c *client) ShowOrganizationContext(ctx context.Context, id int64) (*Organization, error) {
 	out := new(APIPayload)
-	err := c.get(fmt.Sprintf("/api/v2/organizations/%d.json", id), out)
+	err := c.get(ctx, "ShowOrganization", routef("/api/v2/organizations/%d.json", id), out)
 	return out.Organization, err
 }
 
 // ShowManyOrganizations accepts a comma-separated list of organization ids or external ids.
+// Like ShowManyUsers, the ids are sent in chunks of 100, the failed chunks are
+// reported as ChunkErrors and the organizations are returned in the order of
+// the ids.
 //
 // Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/support/organizations#show-many-organizations
 func (c *client) ShowManyOrganizations(ids []int64) ([]Organization, error) {
-	var sids []string
-	for _, id := range ids {
-		sids = append(sids, strconv.FormatInt(id, 10))
-	}
+	return c.ShowManyOrganizationsContext(context.Background(), ids)
+}
 
-	out := new(APIPayload)
-	err := c.get(fmt.Sprintf("/api/v2/organizations/show_many.json?ids=%s", strings.Join(sids, ",")), out)
-	return out.Organizations, err
+// ShowManyOrganizationsContext is like ShowManyOrganizations but uses ctx for the underlying request.
+func (c *client) ShowManyOrganizationsContext(ctx context.Context, ids []int64) ([]Organization, error) {
+	orgs, err := chunked(ctx, c, ids, func(ctx context.Context, ids []int64) ([]Organization, error) {
+		var sids []string
+		for _, id := range ids {
+			sids = append(sids, strconv.FormatInt(id, 10))
+		}
+
+		out := new(APIPayload)
+		err := c.get(ctx, "ShowManyOrganizations", routef("/api/v2/organizations/show_many.json?ids=%s", strings.Join(sids, ",")), out)
+		return out.Organizations, err
+	})
+
+	sortByInput(orgs, ids, func(org *Organization) (int64, bool) {
+		if org.ID == nil {
+			return 0, false
+		}
+		return *org.ID, true
+	})
+	return orgs, err
 }
 
 // CreateOrganization creates an organization.
 //
 // Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/core/organizations#create-organization
 func (c *client) CreateOrganization(org *Organization) (*Organization, error) {
+	return c.CreateOrganizationContext(context.Background(), org)
+}
+
+// CreateOrganizationContext is like CreateOrganization but uses ctx for the underlying request.
+func (c *client) CreateOrganizationContext(ctx context.Context, org *Organization) (*Organization, error) {
 	in := &APIPayload{Organization: org}
 	out := new(APIPayload)
-	err := c.post("/api/v2/organizations.json", in, out)
+	err := c.post(ctx, "CreateOrganization", routef("/api/v2/organizations.json"), in, out)
 	return out.Organization, err
 }
 
@@ -67,9 +93,14 @@ func (c *client) CreateOrganization(org *Organization) (*Organization, error) {
 //
 // Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/core/organizations#create-or-update-organization
 func (c *client) CreateOrUpdateOrganization(org *Organization) (*Organization, error) {
+	return c.CreateOrUpdateOrganizationContext(context.Background(), org)
+}
+
+// CreateOrUpdateOrganizationContext is like CreateOrUpdateOrganization but uses ctx for the underlying request.
+func (c *client) CreateOrUpdateOrganizationContext(ctx context.Context, org *Organization) (*Organization, error) {
 	in := &APIPayload{Organization: org}
 	out := new(APIPayload)
-	err := c.post("/api/v2/organizations/create_or_update.json", in, out)
+	err := c.post(ctx, "CreateOrUpdateOrganization", routef("/api/v2/organizations/create_or_update.json"), in, out)
 	return out.Organization, err
 }
 
@@ -77,40 +108,151 @@ func (c *client) CreateOrUpdateOrganization(org *Organization) (*Organization, e
 //
 // Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/core/organizations#update-organization
 func (c *client) UpdateOrganization(id int64, org *Organization) (*Organization, error) {
+	return c.UpdateOrganizationContext(context.Background(), id, org)
+}
+
+// UpdateOrganizationContext is like UpdateOrganization but uses ctx for the underlying request.
+func (c *client) UpdateOrganizationContext(ctx context.Context, id int64, org *Organization) (*Organization, error) {
 	in := &APIPayload{Organization: org}
 	out := new(APIPayload)
-	err := c.put(fmt.Sprintf("/api/v2/organizations/%d.json", id), in, out)
+	err := c.put(ctx, "UpdateOrganization", routef("/api/v2/organizations/%d.json", id), in, out)
 	return out.Organization, err
 }
 
+// CreateManyOrganizations creates organizations in background jobs, one for
+// each chunk of 100 organizations.
+//
+// Unlike users, organizations have no create_or_update_many endpoint: Zendesk
+// only offers create_or_update for a single organization, so the organizations
+// to upsert must either be split between CreateManyOrganizations and
+// UpdateManyOrganizations or be sent one at a time with CreateOrUpdateOrganization.
+//
+// Zendesk Core API docs: https://developer.zendesk.com/api-reference/ticketing/organizations/organizations/#create-many-organizations
+func (c *client) CreateManyOrganizations(orgs []Organization) ([]JobStatus, error) {
+	return c.CreateManyOrganizationsContext(context.Background(), orgs)
+}
+
+// CreateManyOrganizationsContext is like CreateManyOrganizations but uses ctx for the underlying request.
+func (c *client) CreateManyOrganizationsContext(ctx context.Context, orgs []Organization) ([]JobStatus, error) {
+	return chunked(ctx, c, orgs, func(ctx context.Context, orgs []Organization) ([]JobStatus, error) {
+		in := &APIPayload{Organizations: orgs}
+		out := new(APIPayload)
+		err := c.post(ctx, "CreateManyOrganizations", routef("/api/v2/organizations/create_many.json"), in, out)
+		return jobStatuses(out, err)
+	})
+}
+
+// UpdateManyOrganizations updates organizations, identified by their id, in
+// background jobs, one for each chunk of 100 organizations.
+//
+// Zendesk Core API docs: https://developer.zendesk.com/api-reference/ticketing/organizations/organizations/#update-many-organizations
+func (c *client) UpdateManyOrganizations(orgs []Organization) ([]JobStatus, error) {
+	return c.UpdateManyOrganizationsContext(context.Background(), orgs)
+}
+
+// UpdateManyOrganizationsContext is like UpdateManyOrganizations but uses ctx for the underlying request.
+func (c *client) UpdateManyOrganizationsContext(ctx context.Context, orgs []Organization) ([]JobStatus, error) {
+	return chunked(ctx, c, orgs, func(ctx context.Context, orgs []Organization) ([]JobStatus, error) {
+		in := &APIPayload{Organizations: orgs}
+		out := new(APIPayload)
+		err := c.put(ctx, "UpdateManyOrganizations", routef("/api/v2/organizations/update_many.json"), in, out)
+		return jobStatuses(out, err)
+	})
+}
+
+// DestroyManyOrganizations deletes organizations in background jobs, one for
+// each chunk of 100 organizations.
+//
+// Zendesk Core API docs: https://developer.zendesk.com/api-reference/ticketing/organizations/organizations/#bulk-delete-organizations
+func (c *client) DestroyManyOrganizations(ids []int64) ([]JobStatus, error) {
+	return c.DestroyManyOrganizationsContext(context.Background(), ids)
+}
+
+// DestroyManyOrganizationsContext is like DestroyManyOrganizations but uses ctx for the underlying request.
+func (c *client) DestroyManyOrganizationsContext(ctx context.Context, ids []int64) ([]JobStatus, error) {
+	return chunked(ctx, c, ids, func(ctx context.Context, ids []int64) ([]JobStatus, error) {
+		out := new(APIPayload)
+		err := c.delete(ctx, "DestroyManyOrganizations", routef("/api/v2/organizations/destroy_many.json?ids=%s", joinIDs(ids)), out)
+		return jobStatuses(out, err)
+	})
+}
+
 // ListOrganizations list all organizations.
 //
 // Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/core/organizations#list-organizations
 func (c *client) ListOrganizations(opts *ListOptions) ([]Organization, error) {
-	params, err := query.Values(opts)
+	return c.ListOrganizationsContext(context.Background(), opts)
+}
+
+// ListOrganizationsContext is like ListOrganizations but uses ctx for the underlying request.
+func (c *client) ListOrganizationsContext(ctx context.Context, opts *ListOptions) ([]Organization, error) {
+	params, err := listParams(opts)
 	if err != nil {
 		return nil, err
 	}
 
 	out := new(APIPayload)
-	err = c.get("/api/v2/organizations.json?"+params.Encode(), out)
+	err = c.get(ctx, "ListOrganizations", routef("/api/v2/organizations.json?%s", params.Encode()), out)
 	return out.Organizations, err
 }
 
+// ListOrganizationsPager is like ListOrganizations but returns a Pager over all the pages.
+func (c *client) ListOrganizationsPager(opts *ListOptions) *Pager[Organization] {
+	params, err := listParams(opts)
+	rt := routef("/api/v2/organizations.json?%s", params.Encode())
+	return newURLPager(c, "ListOrganizationsPager", rt, err, func(out *APIPayload) []Organization { return out.Organizations })
+}
+
+// ListOrganizationsCursor lists all organizations using cursor pagination.
+//
+// Zendesk Core API docs: https://developer.zendesk.com/api-reference/introduction/pagination/#using-cursor-pagination
+func (c *client) ListOrganizationsCursor(options *CursorOptions) (*ListResponse, error) {
+	return c.ListOrganizationsCursorContext(context.Background(), options)
+}
+
+// ListOrganizationsCursorContext is like ListOrganizationsCursor but uses ctx for the underlying request.
+func (c *client) ListOrganizationsCursorContext(ctx context.Context, options *CursorOptions) (*ListResponse, error) {
+	params, err := listParams(options)
+	if err != nil {
+		return nil, err
+	}
+
+	out := new(APIPayload)
+	err = c.get(ctx, "ListOrganizationsCursor", routef("/api/v2/organizations.json?%s", params.Encode()), out)
+	if err != nil {
+		return nil, err
+	}
+	return &ListResponse{
+		Organizations: out.Organizations,
+		Meta:          out.Meta,
+		Links:         out.Links,
+	}, nil
+}
+
 // DeleteOrganization deletes an Organization.
 //
 // Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/core/organizations#delete-organization
 func (c *client) DeleteOrganization(id int64) error {
-	return c.delete(fmt.Sprintf("/api/v2/organizations/%d.json", id), nil)
+	return c.DeleteOrganizationContext(context.Background(), id)
+}
+
+// DeleteOrganizationContext is like DeleteOrganization but uses ctx for the underlying request.
+func (c *client) DeleteOrganizationContext(ctx context.Context, id int64) error {
+	return c.delete(ctx, "DeleteOrganization", routef("/api/v2/organizations/%d.json", id), nil)
 }
 
 // AutocompleteOrganizations returns an array of organizations whose name starts with the value specified in the name parameter.
 // Note: name is case-insensitive
 // Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/core/organizations#autocomplete-organizations
 func (c *client) AutocompleteOrganizations(name string) ([]Organization, error) {
+	return c.AutocompleteOrganizationsContext(context.Background(), name)
+}
+
+// AutocompleteOrganizationsContext is like AutocompleteOrganizations but uses ctx for the underlying request.
+func (c *client) AutocompleteOrganizationsContext(ctx context.Context, name string) ([]Organization, error) {
 	out := new(APIPayload)
 	name = url.QueryEscape(name)
-	err := c.get("/api/v2/organizations/autocomplete.json?name="+name, out)
+	err := c.get(ctx, "AutocompleteOrganizations", routef("/api/v2/organizations/autocomplete.json?name=%s", name), out)
 	return out.Organizations, err
 }
 
@@ -118,7 +260,12 @@ func (c *client) AutocompleteOrganizations(name string) ([]Organization, error)
 //
 // Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/core/organizations#search-organizations-by-external-id
 func (c *client) SearchOrganizationsByExternalID(id string) ([]Organization, error) {
+	return c.SearchOrganizationsByExternalIDContext(context.Background(), id)
+}
+
+// SearchOrganizationsByExternalIDContext is like SearchOrganizationsByExternalID but uses ctx for the underlying request.
+func (c *client) SearchOrganizationsByExternalIDContext(ctx context.Context, id string) ([]Organization, error) {
 	out := new(APIPayload)
-	err := c.get(fmt.Sprintf("/api/v2/organizations/search.json?external_id=%s", id), out)
+	err := c.get(ctx, "SearchOrganizationsByExternalID", routef("/api/v2/organizations/search.json?external_id=%s", id), out)
 	return out.Organizations, err
 }
@@ -1,7 +1,7 @@
 package zendesk
 
 import (
-	"fmt"
+	"context"
 	"time"
 )
 
@@ -22,24 +22,104 @@ type OrganizationMembership struct {
 //
 // Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/core/organization_memberships#create-membership
 func (c *client) CreateOrganizationMembership(orgMembership *OrganizationMembership) (*OrganizationMembership, error) {
+	return c.CreateOrganizationMembershipContext(context.Background(), orgMembership)
+}
+
+// CreateOrganizationMembershipContext is like CreateOrganizationMembership but uses ctx for the underlying request.
+func (c *client) CreateOrganizationMembershipContext(ctx context.Context, orgMembership *OrganizationMembership) (*OrganizationMembership, error) {
 	in := &APIPayload{OrganizationMembership: orgMembership}
 	out := new(APIPayload)
-	err := c.post("/api/v2/organization_memberships.json", in, out)
+	err := c.post(ctx, "CreateOrganizationMembership", routef("/api/v2/organization_memberships.json"), in, out)
 	return out.OrganizationMembership, err
 }
 
+// CreateManyOrganizationMemberships creates organization memberships in
+// background jobs, one for each chunk of 100 organization memberships.
+//
+// There's no UpdateManyOrganizationMemberships since Zendesk has no update_many
+// endpoint for memberships: a membership only links a user to an organization,
+// so changing it means destroying it and creating a new one.
+//
+// Zendesk Core API docs: https://developer.zendesk.com/api-reference/ticketing/organizations/organization_memberships/#create-many-memberships
+func (c *client) CreateManyOrganizationMemberships(memberships []OrganizationMembership) ([]JobStatus, error) {
+	return c.CreateManyOrganizationMembershipsContext(context.Background(), memberships)
+}
+
+// CreateManyOrganizationMembershipsContext is like CreateManyOrganizationMemberships but uses ctx for the underlying request.
+func (c *client) CreateManyOrganizationMembershipsContext(ctx context.Context, memberships []OrganizationMembership) ([]JobStatus, error) {
+	return chunked(ctx, c, memberships, func(ctx context.Context, memberships []OrganizationMembership) ([]JobStatus, error) {
+		in := &APIPayload{OrganizationMemberships: memberships}
+		out := new(APIPayload)
+		err := c.post(ctx, "CreateManyOrganizationMemberships", routef("/api/v2/organization_memberships/create_many.json"), in, out)
+		return jobStatuses(out, err)
+	})
+}
+
+// DestroyManyOrganizationMemberships deletes organization memberships in
+// background jobs, one for each chunk of 100 organization memberships.
+//
+// Zendesk Core API docs: https://developer.zendesk.com/api-reference/ticketing/organizations/organization_memberships/#bulk-delete-memberships
+func (c *client) DestroyManyOrganizationMemberships(ids []int64) ([]JobStatus, error) {
+	return c.DestroyManyOrganizationMembershipsContext(context.Background(), ids)
+}
+
+// DestroyManyOrganizationMembershipsContext is like DestroyManyOrganizationMemberships but uses ctx for the underlying request.
+func (c *client) DestroyManyOrganizationMembershipsContext(ctx context.Context, ids []int64) ([]JobStatus, error) {
+	return chunked(ctx, c, ids, func(ctx context.Context, ids []int64) ([]JobStatus, error) {
+		out := new(APIPayload)
+		err := c.delete(ctx, "DestroyManyOrganizationMemberships", routef("/api/v2/organization_memberships/destroy_many.json?ids=%s", joinIDs(ids)), out)
+		return jobStatuses(out, err)
+	})
+}
+
 // ListOrganizationMembershipsByUserID returns all organization memberships for a specific user
 //
 // Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/core/organization_memberships#list-memberships
 func (c *client) ListOrganizationMembershipsByUserID(id int64) ([]OrganizationMembership, error) {
+	return c.ListOrganizationMembershipsByUserIDContext(context.Background(), id)
+}
+
+// ListOrganizationMembershipsByUserIDContext is like ListOrganizationMembershipsByUserID but uses ctx for the underlying request.
+func (c *client) ListOrganizationMembershipsByUserIDContext(ctx context.Context, id int64) ([]OrganizationMembership, error) {
 	out := new(APIPayload)
-	err := c.get(fmt.Sprintf("/api/v2/users/%d/organization_memberships.json", id), out)
+	err := c.get(ctx, "ListOrganizationMembershipsByUserID", routef("/api/v2/users/%d/organization_memberships.json", id), out)
 	return out.OrganizationMemberships, err
 }
 
+// ListOrganizationMembershipsCursor lists all organization memberships using cursor pagination.
+//
+// Zendesk Core API docs: https://developer.zendesk.com/api-reference/introduction/pagination/#using-cursor-pagination
+func (c *client) ListOrganizationMembershipsCursor(options *CursorOptions) (*ListResponse, error) {
+	return c.ListOrganizationMembershipsCursorContext(context.Background(), options)
+}
+
+// ListOrganizationMembershipsCursorContext is like ListOrganizationMembershipsCursor but uses ctx for the underlying request.
+func (c *client) ListOrganizationMembershipsCursorContext(ctx context.Context, options *CursorOptions) (*ListResponse, error) {
+	params, err := listParams(options)
+	if err != nil {
+		return nil, err
+	}
+
+	out := new(APIPayload)
+	err = c.get(ctx, "ListOrganizationMembershipsCursor", routef("/api/v2/organization_memberships.json?%s", params.Encode()), out)
+	if err != nil {
+		return nil, err
+	}
+	return &ListResponse{
+		OrganizationMemberships: out.OrganizationMemberships,
+		Meta:                    out.Meta,
+		Links:                   out.Links,
+	}, nil
+}
+
 // DeleteOrganizationMembership removes an organization membership
 //
 // Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/core/organization_memberships#delete-membership
 func (c *client) DeleteOrganizationMembershipByID(id int64) error {
-	return c.delete(fmt.Sprintf("/api/v2/organization_memberships/%d.json", id), nil)
+	return c.DeleteOrganizationMembershipByIDContext(context.Background(), id)
+}
+
+// DeleteOrganizationMembershipByIDContext is like DeleteOrganizationMembershipByID but uses ctx for the underlying request.
+func (c *client) DeleteOrganizationMembershipByIDContext(ctx context.Context, id int64) error {
+	return c.delete(ctx, "DeleteOrganizationMembershipByID", routef("/api/v2/organization_memberships/%d.json", id), nil)
 }
@@ -0,0 +1,154 @@
+// Package otelzendesk instruments the Zendesk client with OpenTelemetry traces and metrics.
+package otelzendesk
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/MEDIGO/go-zendesk/zendesk"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ScopeName is the instrumentation scope name used by the tracer and meter.
+const ScopeName = "github.com/MEDIGO/go-zendesk/zendesk/otelzendesk"
+
+// Attribute keys set on spans and metrics.
+const (
+	operationKey   = attribute.Key("zendesk.operation")
+	requestIDKey   = attribute.Key("zendesk.request_id")
+	urlTemplateKey = attribute.Key("url.template")
+	methodKey      = attribute.Key("http.request.method")
+	statusCodeKey  = attribute.Key("http.response.status_code")
+	resendCountKey = attribute.Key("http.request.resend_count")
+	serverAddrKey  = attribute.Key("server.address")
+	errorTypeKey   = attribute.Key("error.type")
+)
+
+type config struct {
+	tracerProvider trace.TracerProvider
+	meterProvider  metric.MeterProvider
+}
+
+// Option configures the instrumentation.
+type Option func(*config)
+
+// WithTracerProvider sets the TracerProvider used to create spans. Defaults to
+// the global TracerProvider.
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return func(c *config) {
+		c.tracerProvider = tp
+	}
+}
+
+// WithMeterProvider sets the MeterProvider used to record metrics. Defaults to
+// the global MeterProvider.
+func WithMeterProvider(mp metric.MeterProvider) Option {
+	return func(c *config) {
+		c.meterProvider = mp
+	}
+}
+
+// WithTelemetry returns a ClientOption that records a span, a request count and
+// a latency measurement for every request sent by the client. Each retry is
+// recorded as a separate span with its resend count.
+//
+// Since it's installed as a middleware, it applies to any HTTP client set with
+// WithHTTPClient and carries over to the clients returned by WithHeader.
+func WithTelemetry(opts ...Option) zendesk.ClientOption {
+	cfg := &config{
+		tracerProvider: otel.GetTracerProvider(),
+		meterProvider:  otel.GetMeterProvider(),
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	tracer := cfg.tracerProvider.Tracer(ScopeName)
+	meter := cfg.meterProvider.Meter(ScopeName)
+
+	requests, err := meter.Int64Counter("zendesk.client.requests",
+		metric.WithDescription("Number of requests sent to the Zendesk API."),
+		metric.WithUnit("{request}"))
+	if err != nil {
+		otel.Handle(err)
+	}
+
+	duration, err := meter.Float64Histogram("zendesk.client.request.duration",
+		metric.WithDescription("Duration of the requests sent to the Zendesk API."),
+		metric.WithUnit("s"))
+	if err != nil {
+		otel.Handle(err)
+	}
+
+	return zendesk.WithMiddleware(func(next zendesk.Handler) zendesk.Handler {
+		return func(call *zendesk.Call) (*http.Response, error) {
+			template := call.Template
+
+			attrs := []attribute.KeyValue{
+				operationKey.String(call.Operation),
+				methodKey.String(call.Request.Method),
+				urlTemplateKey.String(template),
+				serverAddrKey.String(call.Request.URL.Hostname()),
+			}
+
+			spanName := call.Operation
+			if spanName == "" {
+				spanName = call.Request.Method + " " + template
+			}
+
+			ctx, span := tracer.Start(call.Request.Context(), spanName,
+				trace.WithSpanKind(trace.SpanKindClient),
+				trace.WithAttributes(attrs...))
+			defer span.End()
+
+			if call.Attempt > 1 {
+				span.SetAttributes(resendCountKey.Int(call.Attempt - 1))
+			}
+
+			call.Request = call.Request.WithContext(ctx)
+
+			start := time.Now()
+			res, err := next(call)
+			elapsed := time.Since(start)
+
+			if res != nil {
+				attrs = append(attrs, statusCodeKey.Int(res.StatusCode))
+				span.SetAttributes(statusCodeKey.Int(res.StatusCode))
+				if id := res.Header.Get("X-Request-Id"); id != "" {
+					span.SetAttributes(requestIDKey.String(id))
+				}
+			}
+
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+				attrs = append(attrs, errorTypeKey.String(errorType(res, err)))
+			}
+
+			set := metric.WithAttributes(attrs...)
+			if requests != nil {
+				requests.Add(ctx, 1, set)
+			}
+			if duration != nil {
+				duration.Record(ctx, elapsed.Seconds(), set)
+			}
+
+			return res, err
+		}
+	})
+}
+
+// errorType describes an error as the status code of the response, or as the
+// type of the error when no response was received.
+func errorType(res *http.Response, err error) string {
+	if res != nil && res.StatusCode >= 400 {
+		return strconv.Itoa(res.StatusCode)
+	}
+	return fmt.Sprintf("%T", err)
+}
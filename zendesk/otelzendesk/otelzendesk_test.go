@@ -0,0 +1,182 @@
+package otelzendesk
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/MEDIGO/go-zendesk/zendesk"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	metricnoop "go.opentelemetry.io/otel/metric/noop"
+	"go.opentelemetry.io/otel/trace"
+	tracenoop "go.opentelemetry.io/otel/trace/noop"
+)
+
+func TestWithTelemetry(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set("X-Request-Id", "req-1")
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"ticket":{"id":42}}`))
+	}))
+	defer server.Close()
+
+	tp := &tracerProvider{}
+	mp := &meterProvider{measurements: map[string][]measurement{}}
+
+	client, err := zendesk.NewURLClient(server.URL, "", "",
+		zendesk.WithRetryPolicy(zendesk.RetryPolicy{InitialBackoff: time.Millisecond}),
+		WithTelemetry(WithTracerProvider(tp), WithMeterProvider(mp)),
+	)
+	require.NoError(t, err)
+
+	// assert that clones returned by WithHeader are instrumented too
+	_, err = client.WithHeader("foo", "bar").ShowTicket(42)
+	require.NoError(t, err)
+
+	require.Len(t, tp.spans, 2)
+
+	first, second := tp.spans[0], tp.spans[1]
+	require.Equal(t, "ShowTicket", first.name)
+	require.Equal(t, codes.Error, first.status)
+	require.Contains(t, first.attrs, urlTemplateKey.String("/api/v2/tickets/{id}.json"))
+	require.Contains(t, first.attrs, statusCodeKey.Int(http.StatusServiceUnavailable))
+
+	require.Equal(t, codes.Unset, second.status)
+	require.Contains(t, second.attrs, statusCodeKey.Int(http.StatusOK))
+	require.Contains(t, second.attrs, resendCountKey.Int(1))
+	require.Contains(t, second.attrs, requestIDKey.String("req-1"))
+
+	requests := mp.measurements["zendesk.client.requests"]
+	require.Len(t, requests, 2)
+	for _, m := range requests {
+		op, _ := m.attrs.Value(operationKey)
+		require.Equal(t, attribute.StringValue("ShowTicket"), op)
+		require.Equal(t, float64(1), m.value)
+	}
+
+	require.Len(t, mp.measurements["zendesk.client.request.duration"], 2)
+}
+
+func TestWithTelemetryTemplates(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	tp := &tracerProvider{}
+
+	client, err := zendesk.NewURLClient(server.URL, "", "",
+		WithTelemetry(WithTracerProvider(tp), WithMeterProvider(metricnoop.NewMeterProvider())))
+	require.NoError(t, err)
+
+	_, err = client.ShowJobStatus("8b726e606741012ffc2d782bcb7848fe")
+	require.NoError(t, err)
+	_, err = client.ShowLocaleByCode("en-US")
+	require.NoError(t, err)
+	_, err = client.ShowIdentity(1, 2)
+	require.NoError(t, err)
+
+	// assert that the string identifiers are templated too, and that query
+	// strings are left out
+	require.Len(t, tp.spans, 3)
+	require.Contains(t, tp.spans[0].attrs, urlTemplateKey.String("/api/v2/job_statuses/{id}.json"))
+	require.Contains(t, tp.spans[1].attrs, urlTemplateKey.String("/api/v2/locales/{id}.json"))
+	require.Contains(t, tp.spans[2].attrs, urlTemplateKey.String("/api/v2/users/{id}/identities/{id}.json"))
+}
+
+// tracerProvider records the spans started by the instrumentation.
+type tracerProvider struct {
+	tracenoop.TracerProvider
+	spans []*span
+}
+
+func (tp *tracerProvider) Tracer(string, ...trace.TracerOption) trace.Tracer {
+	return &tracer{provider: tp}
+}
+
+type tracer struct {
+	tracenoop.Tracer
+	provider *tracerProvider
+}
+
+func (t *tracer) Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	cfg := trace.NewSpanStartConfig(opts...)
+	s := &span{name: name, attrs: cfg.Attributes()}
+	t.provider.spans = append(t.provider.spans, s)
+	return trace.ContextWithSpan(ctx, s), s
+}
+
+type span struct {
+	tracenoop.Span
+	name   string
+	attrs  []attribute.KeyValue
+	status codes.Code
+}
+
+func (s *span) SetAttributes(kv ...attribute.KeyValue) {
+	s.attrs = append(s.attrs, kv...)
+}
+
+func (s *span) SetStatus(code codes.Code, _ string) {
+	s.status = code
+}
+
+// meterProvider records the measurements of the instrumentation by instrument name.
+type meterProvider struct {
+	metricnoop.MeterProvider
+	measurements map[string][]measurement
+}
+
+type measurement struct {
+	value float64
+	attrs attribute.Set
+}
+
+func (mp *meterProvider) Meter(string, ...metric.MeterOption) metric.Meter {
+	return &meter{provider: mp}
+}
+
+type meter struct {
+	metricnoop.Meter
+	provider *meterProvider
+}
+
+func (m *meter) Int64Counter(name string, _ ...metric.Int64CounterOption) (metric.Int64Counter, error) {
+	return &counter{provider: m.provider, name: name}, nil
+}
+
+func (m *meter) Float64Histogram(name string, _ ...metric.Float64HistogramOption) (metric.Float64Histogram, error) {
+	return &histogram{provider: m.provider, name: name}, nil
+}
+
+type counter struct {
+	metricnoop.Int64Counter
+	provider *meterProvider
+	name     string
+}
+
+func (c *counter) Add(_ context.Context, incr int64, opts ...metric.AddOption) {
+	m := measurement{value: float64(incr), attrs: metric.NewAddConfig(opts).Attributes()}
+	c.provider.measurements[c.name] = append(c.provider.measurements[c.name], m)
+}
+
+type histogram struct {
+	metricnoop.Float64Histogram
+	provider *meterProvider
+	name     string
+}
+
+func (h *histogram) Record(_ context.Context, value float64, opts ...metric.RecordOption) {
+	m := measurement{value: value, attrs: metric.NewRecordConfig(opts).Attributes()}
+	h.provider.measurements[h.name] = append(h.provider.measurements[h.name], m)
+}
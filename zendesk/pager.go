@@ -0,0 +1,153 @@
+package zendesk
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"net/url"
+	"strings"
+
+	"github.com/google/go-querystring/query"
+)
+
+// ErrNoMorePages is returned by Pager.Next when all the pages have been fetched.
+var ErrNoMorePages = errors.New("zendesk: no more pages")
+
+// Pager iterates over the pages of a listing, such as a list endpoint whose pages
+// are chained by their next_page URL.
+//
+//	pager := client.ListTicketsPager(&zendesk.ListOptions{PerPage: 100})
+//	for ticket, err := range pager.Items(ctx) {
+//		if err != nil {
+//			return err
+//		}
+//		...
+//	}
+type Pager[T any] struct {
+	fetch func(context.Context, Page) ([]T, Page, error)
+	page  Page
+	done  bool
+}
+
+// Page is the position of a page in a listing.
+type Page struct {
+	// URL is the URL of the page, as found in the next_page field of the previous one.
+	URL string
+}
+
+// NewPager returns a Pager over the pages returned by fetch, e.g. to return fake
+// pages from a MockClient. fetch is first called with the zero Page, then with
+// the Page it returned last, until it returns the zero Page or an error.
+func NewPager[T any](fetch func(ctx context.Context, page Page) ([]T, Page, error)) *Pager[T] {
+	return &Pager[T]{fetch: fetch}
+}
+
+// newURLPager returns a Pager that starts at the endpoint of rt and follows the
+// next_page URLs. err is returned by the first fetch when it's set.
+func newURLPager[T any](c *client, op string, rt route, err error, extract func(*APIPayload) []T) *Pager[T] {
+	return NewPager(func(ctx context.Context, page Page) ([]T, Page, error) {
+		if err != nil {
+			return nil, Page{}, err
+		}
+
+		if page.URL != "" {
+			// The next pages share the template of the first one.
+			rt = route{endpoint: page.URL, template: rt.template}
+		}
+
+		out := new(APIPayload)
+		if err := c.get(ctx, op, rt, out); err != nil {
+			return nil, Page{}, err
+		}
+
+		var next Page
+		// Time-based incremental exports keep returning a next_page at the end of the stream.
+		if out.NextPage != nil && (out.EndOfStream == nil || !*out.EndOfStream) {
+			next.URL = *out.NextPage
+		}
+
+		return extract(out), next, nil
+	})
+}
+
+// HasNext reports whether there are more pages to fetch.
+func (p *Pager[T]) HasNext() bool {
+	return !p.done
+}
+
+// Next fetches the next page. It returns ErrNoMorePages once all the pages
+// have been fetched. The pager stops after the first error.
+func (p *Pager[T]) Next(ctx context.Context) ([]T, error) {
+	if p.done {
+		return nil, ErrNoMorePages
+	}
+
+	items, next, err := p.fetch(ctx, p.page)
+	if err != nil {
+		p.done = true
+		return nil, err
+	}
+
+	p.page = next
+	p.done = next == Page{}
+
+	return items, nil
+}
+
+// Items returns an iterator over the records of all the remaining pages. The
+// iteration stops after yielding the first error.
+func (p *Pager[T]) Items(ctx context.Context) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for p.HasNext() {
+			page, err := p.Next(ctx)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range page {
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// All fetches the records of all the remaining pages. It stops fetching pages
+// once limit records have been collected, unless limit is zero or negative.
+func (p *Pager[T]) All(ctx context.Context, limit int) ([]T, error) {
+	var items []T
+
+	for item, err := range p.Items(ctx) {
+		if err != nil {
+			return items, err
+		}
+
+		items = append(items, item)
+		if limit > 0 && len(items) >= limit {
+			break
+		}
+	}
+
+	return items, nil
+}
+
+// listParams encodes the list options and side-loads of a list request.
+func listParams(options interface{}, sideloads ...SideLoad) (url.Values, error) {
+	params, err := query.Values(options)
+	if err != nil {
+		return nil, err
+	}
+
+	sideLoads := &SideLoadOptions{}
+	for _, opt := range sideloads {
+		opt(sideLoads)
+	}
+	if len(sideLoads.Include) > 0 {
+		params.Set("include", strings.Join(sideLoads.Include, ","))
+	}
+
+	return params, nil
+}
@@ -0,0 +1,134 @@
+package zendesk_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/MEDIGO/go-zendesk/zendesk"
+	"github.com/stretchr/testify/require"
+)
+
+func newPagedServer(t *testing.T, pages int, requested *[]string) *httptest.Server {
+	var server *httptest.Server
+
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requested = append(*requested, r.URL.RequestURI())
+
+		page := 1
+		fmt.Sscan(r.URL.Query().Get("page"), &page)
+
+		next := "null"
+		if page < pages {
+			next = fmt.Sprintf(`"%s/api/v2/users.json?page=%d&per_page=2"`, server.URL, page+1)
+		}
+
+		fmt.Fprintf(w, `{"users":[{"id":%d},{"id":%d}],"next_page":%s}`, 2*page-1, 2*page, next)
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestPagerItems(t *testing.T) {
+	var requested []string
+	server := newPagedServer(t, 3, &requested)
+
+	client, err := zendesk.NewURLClient(server.URL, "", "")
+	require.NoError(t, err)
+
+	var ids []int64
+	for user, err := range client.ListUsersPager(&zendesk.ListUsersOptions{ListOptions: zendesk.ListOptions{PerPage: 2}}).Items(context.Background()) {
+		require.NoError(t, err)
+		ids = append(ids, *user.ID)
+	}
+
+	require.Equal(t, []int64{1, 2, 3, 4, 5, 6}, ids)
+	require.Len(t, requested, 3)
+	require.Equal(t, []string{
+		"/api/v2/users.json?page=2&per_page=2",
+		"/api/v2/users.json?page=3&per_page=2",
+	}, requested[1:])
+}
+
+func TestPagerAllWithLimit(t *testing.T) {
+	var requested []string
+	server := newPagedServer(t, 5, &requested)
+
+	client, err := zendesk.NewURLClient(server.URL, "", "")
+	require.NoError(t, err)
+
+	users, err := client.ListUsersPager(nil).All(context.Background(), 3)
+	require.NoError(t, err)
+	require.Len(t, users, 3)
+	require.Len(t, requested, 2, "expected to stop fetching once the limit is reached")
+}
+
+func TestPagerNext(t *testing.T) {
+	var requested []string
+	server := newPagedServer(t, 2, &requested)
+
+	client, err := zendesk.NewURLClient(server.URL, "", "")
+	require.NoError(t, err)
+
+	pager := client.ListUsersPager(nil)
+
+	var pages int
+	for pager.HasNext() {
+		users, err := pager.Next(context.Background())
+		require.NoError(t, err)
+		require.Len(t, users, 2)
+		pages++
+	}
+	require.Equal(t, 2, pages)
+
+	_, err = pager.Next(context.Background())
+	require.Equal(t, zendesk.ErrNoMorePages, err)
+}
+
+func TestNewPager(t *testing.T) {
+	pages := [][]int64{{1, 2}, {3}}
+
+	fetch := func(ctx context.Context, page zendesk.Page) ([]zendesk.User, zendesk.Page, error) {
+		i := 0
+		fmt.Sscan(page.URL, &i)
+
+		var users []zendesk.User
+		for _, id := range pages[i] {
+			users = append(users, zendesk.User{ID: zendesk.Int(id)})
+		}
+
+		var next zendesk.Page
+		if i+1 < len(pages) {
+			next.URL = fmt.Sprint(i + 1)
+		}
+		return users, next, nil
+	}
+
+	client := new(zendesk.MockClient)
+	client.On("ListUsersPager", (*zendesk.ListUsersOptions)(nil)).Return(zendesk.NewPager(fetch))
+
+	users, err := client.ListUsersPager(nil).All(context.Background(), 0)
+	require.NoError(t, err)
+	require.Len(t, users, 3)
+	require.Equal(t, int64(3), *users[2].ID)
+	client.AssertExpectations(t)
+}
+
+func TestPagerError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"error":"Forbidden"}`, http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	client, err := zendesk.NewURLClient(server.URL, "", "")
+	require.NoError(t, err)
+
+	pager := client.ListGroupsPager(nil)
+	groups, err := pager.All(context.Background(), 0)
+	require.Error(t, err)
+	require.Empty(t, groups)
+	require.False(t, pager.HasNext())
+}
@@ -0,0 +1,222 @@
+package zendesk
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Query builds a query of the unified search api. Its methods append a
+// condition and return the query, so that they can be chained:
+//
+//	q := zendesk.NewQuery().
+//		Status(zendesk.LessThan, zendesk.StatusSolved).
+//		Tags("vip").
+//		NotTags("spam").
+//		Created(zendesk.GreaterThan, zendesk.RelativeDate(2, zendesk.DateUnitDays)).
+//		OrderBy("updated_at", zendesk.SortOrderDescending)
+//	results, err := client.SearchTickets(q.String(), nil)
+//
+// Values are quoted when they contain spaces, quotes or other characters that
+// are part of the search syntax.
+//
+// Zendesk Core API docs: https://support.zendesk.com/hc/en-us/articles/203663226
+type Query struct {
+	terms []string
+}
+
+// NewQuery returns an empty Query.
+func NewQuery() *Query {
+	return &Query{}
+}
+
+// String renders the query.
+func (q *Query) String() string {
+	return strings.Join(q.terms, " ")
+}
+
+// Filter returns the query as a search filter.
+func (q *Query) Filter() Filters {
+	return func(c *QueryOptions) {
+		if len(q.terms) > 0 {
+			c.Search = append(c.Search, q.String())
+		}
+	}
+}
+
+// Text matches the records containing all the words of text.
+func (q *Query) Text(text string) *Query {
+	for _, word := range strings.Fields(text) {
+		q.terms = append(q.terms, quoteSearchValue(word))
+	}
+	return q
+}
+
+// Phrase matches the records containing the exact phrase.
+func (q *Query) Phrase(phrase string) *Query {
+	q.terms = append(q.terms, `"`+escapeSearchQuotes(phrase)+`"`)
+	return q
+}
+
+// Type restricts the results to the records of the given type.
+func (q *Query) Type(t ResultType) *Query {
+	return q.Field("type", Equality, string(t))
+}
+
+// Field matches the records whose field compares to value with op.
+func (q *Query) Field(name string, op SearchOperator, value string) *Query {
+	q.terms = append(q.terms, name+string(op)+quoteSearchValue(value))
+	return q
+}
+
+// Not excludes the records whose field matches value.
+func (q *Query) Not(name string, value string) *Query {
+	q.terms = append(q.terms, "-"+name+string(Equality)+quoteSearchValue(value))
+	return q
+}
+
+// Any matches the records whose field matches any of values. Zendesk combines
+// the conditions on a same field with OR, and the other conditions with AND.
+func (q *Query) Any(name string, values ...string) *Query {
+	for _, value := range values {
+		q.Field(name, Equality, value)
+	}
+	return q
+}
+
+// Status matches the tickets whose status compares to s with op.
+func (q *Query) Status(op SearchOperator, s Status) *Query {
+	return q.Field("status", op, string(s))
+}
+
+// Assignee matches the tickets assigned to the user with the given name, email
+// or id, or "none" for unassigned tickets.
+func (q *Query) Assignee(user string) *Query {
+	return q.Field("assignee", Equality, user)
+}
+
+// Requester matches the tickets requested by the user with the given name, email or id.
+func (q *Query) Requester(user string) *Query {
+	return q.Field("requester", Equality, user)
+}
+
+// Organization matches the records of the organization with the given name or id.
+func (q *Query) Organization(org string) *Query {
+	return q.Field("organization", Equality, org)
+}
+
+// Group matches the tickets of the group with the given name or id.
+func (q *Query) Group(group string) *Query {
+	return q.Field("group", Equality, group)
+}
+
+// Tags matches the records with any of the tags.
+func (q *Query) Tags(tags ...string) *Query {
+	return q.Any("tags", tags...)
+}
+
+// NotTags excludes the records with any of the tags.
+func (q *Query) NotTags(tags ...string) *Query {
+	for _, tag := range tags {
+		q.Not("tags", tag)
+	}
+	return q
+}
+
+// CustomField matches the tickets whose custom field has the given value.
+func (q *Query) CustomField(id int64, value string) *Query {
+	return q.Field("custom_field_"+strconv.FormatInt(id, 10), Equality, value)
+}
+
+// Created matches the records whose creation date compares to date with op.
+func (q *Query) Created(op SearchOperator, date DateValue) *Query {
+	return q.date("created", op, date)
+}
+
+// Updated matches the records whose last update date compares to date with op.
+func (q *Query) Updated(op SearchOperator, date DateValue) *Query {
+	return q.date("updated", op, date)
+}
+
+// Solved matches the tickets whose solve date compares to date with op.
+func (q *Query) Solved(op SearchOperator, date DateValue) *Query {
+	return q.date("solved", op, date)
+}
+
+// Between matches the records whose date field is between from and to, both included.
+func (q *Query) Between(field string, from, to DateValue) *Query {
+	q.date(field, GreaterThanOrEqualTo, from)
+	return q.date(field, LessThanOrEqualTo, to)
+}
+
+// date appends a date condition. Dates are never quoted, as Zendesk doesn't
+// parse quoted dates.
+func (q *Query) date(field string, op SearchOperator, date DateValue) *Query {
+	q.terms = append(q.terms, field+string(op)+string(date))
+	return q
+}
+
+// OrderBy sorts the results by field, one of created, updated, priority,
+// status or ticket_type, in the given order.
+func (q *Query) OrderBy(field string, order SortOrder) *Query {
+	q.terms = append(q.terms, "order_by:"+field)
+	if order != "" {
+		q.terms = append(q.terms, "sort:"+string(order))
+	}
+	return q
+}
+
+// SortOrder represents the order of the search results.
+type SortOrder string
+
+const (
+	SortOrderAscending  SortOrder = "asc"
+	SortOrderDescending SortOrder = "desc"
+)
+
+// DateValue is a date usable in the date conditions of a Query, either absolute
+// or relative to the current time.
+type DateValue string
+
+// Date returns the absolute date of t. Times that aren't at midnight UTC keep
+// their time of day.
+func Date(t time.Time) DateValue {
+	t = t.UTC()
+	if t.Equal(t.Truncate(24 * time.Hour)) {
+		return DateValue(t.Format("2006-01-02"))
+	}
+	return DateValue(t.Format(time.RFC3339))
+}
+
+// DateUnit is the unit of a relative date.
+type DateUnit string
+
+const (
+	DateUnitMinutes DateUnit = "minutes"
+	DateUnitHours   DateUnit = "hours"
+	DateUnitDays    DateUnit = "days"
+	DateUnitWeeks   DateUnit = "weeks"
+	DateUnitMonths  DateUnit = "months"
+	DateUnitYears   DateUnit = "years"
+)
+
+// RelativeDate returns the date n units before the time of the search. For
+// instance Created(GreaterThan, RelativeDate(4, DateUnitHours)) matches the records
+// created in the last four hours.
+func RelativeDate(n int, unit DateUnit) DateValue {
+	return DateValue(fmt.Sprintf("%d%s", n, unit))
+}
+
+// quoteSearchValue quotes value when it contains characters that are part of
+// the search syntax.
+func quoteSearchValue(value string) string {
+	if value != "" && !strings.HasPrefix(value, "-") && !strings.ContainsAny(value, " \t\n\":()<>") {
+		return value
+	}
+	return `"` + escapeSearchQuotes(value) + `"`
+}
+
+func escapeSearchQuotes(value string) string {
+	return strings.ReplaceAll(value, `"`, `\"`)
+}
@@ -0,0 +1,54 @@
+package zendesk_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/MEDIGO/go-zendesk/zendesk"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryString(t *testing.T) {
+	q := zendesk.NewQuery().
+		Text("printer jam").
+		Phrase(`says "hello"`).
+		Status(zendesk.LessThan, zendesk.StatusSolved).
+		Assignee("none").
+		Requester("Jane Doe").
+		Tags("vip", "gold").
+		NotTags("spam").
+		CustomField(360001, "yes: really").
+		Created(zendesk.GreaterThan, zendesk.RelativeDate(4, zendesk.DateUnitHours)).
+		Between("updated", zendesk.Date(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)), zendesk.Date(time.Date(2020, 1, 31, 12, 30, 0, 0, time.UTC))).
+		Field("subject", zendesk.Equality, "-leading dash").
+		OrderBy("created", zendesk.SortOrderDescending)
+
+	require.Equal(t, `printer jam "says \"hello\"" status<solved assignee:none requester:"Jane Doe" tags:vip tags:gold -tags:spam custom_field_360001:"yes: really" created>4hours updated>=2020-01-01 updated<=2020-01-31T12:30:00Z subject:"-leading dash" order_by:created sort:desc`, q.String())
+}
+
+func TestSearchTicketsWithQuery(t *testing.T) {
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.Query().Get("query"))
+		fmt.Fprint(w, `{"results":[],"count":0}`)
+	}))
+	defer server.Close()
+
+	client, err := zendesk.NewURLClient(server.URL, "", "")
+	require.NoError(t, err)
+
+	q := zendesk.NewQuery().Tags("vip").Phrase("out of paper")
+
+	_, err = client.SearchTickets(q.String(), nil, zendesk.StatusFilter(zendesk.StatusOpen, zendesk.Equality))
+	require.NoError(t, err)
+	_, err = client.SearchUsersEx("", nil, q.Filter(), zendesk.GroupNameFilter("Tier 1"))
+	require.NoError(t, err)
+
+	require.Equal(t, []string{
+		`type:ticket status:open tags:vip "out of paper"`,
+		`type:user tags:vip "out of paper" group:"Tier 1"`,
+	}, queries)
+}
@@ -0,0 +1,156 @@
+package zendesk
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// minRateFraction is the slowest pace the rate limiter falls back to, as a
+	// fraction of its configured rate, when the account is about to run out of budget.
+	minRateFraction = 0.1
+	// defaultRetryAfter is how long to wait after being rate limited when Zendesk
+	// doesn't say when to retry.
+	defaultRetryAfter = time.Minute
+	// maxRateLimitRetries is how many times withRateLimitRetry retries a
+	// rate-limited call before giving up.
+	maxRateLimitRetries = 5
+)
+
+// WithRateLimit paces the requests sent by the client to at most
+// requestsPerMinute. The pace is further slowed down as the X-Rate-Limit-Remaining
+// header reported by Zendesk approaches zero, so that the client backs off before
+// requests start getting rejected.
+//
+// Clients returned by WithHeader share the limiter of the client they were created from.
+func WithRateLimit(requestsPerMinute int) ClientOption {
+	return WithBurstRateLimit(requestsPerMinute, 1)
+}
+
+// WithBurstRateLimit is like WithRateLimit but lets up to burst requests go
+// out back to back after the client has been idle.
+func WithBurstRateLimit(requestsPerMinute, burst int) ClientOption {
+	return func(c *client) {
+		c.limiter = newBurstRateLimiter(requestsPerMinute, burst)
+	}
+}
+
+// rateLimiter is a token bucket holding up to burst tokens, refilled at a rate
+// adapted from the rate-limit headers of the responses.
+type rateLimiter struct {
+	mu        sync.Mutex
+	perMinute float64
+	burst     float64
+	rate      float64 // tokens per second
+	tokens    float64
+	last      time.Time
+}
+
+func newRateLimiter(requestsPerMinute int) *rateLimiter {
+	return newBurstRateLimiter(requestsPerMinute, 1)
+}
+
+func newBurstRateLimiter(requestsPerMinute, burst int) *rateLimiter {
+	perMinute := float64(requestsPerMinute)
+	if perMinute <= 0 {
+		perMinute = 1
+	}
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &rateLimiter{
+		perMinute: perMinute,
+		burst:     float64(burst),
+		rate:      perMinute / 60,
+		tokens:    float64(burst),
+		last:      time.Now(),
+	}
+}
+
+// wait blocks until a request may be sent or ctx is done.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	l.mu.Lock()
+	now := time.Now()
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+	l.last = now
+	l.tokens--
+
+	var delay time.Duration
+	if l.tokens < 0 {
+		delay = time.Duration(-l.tokens / l.rate * float64(time.Second))
+	}
+	l.mu.Unlock()
+
+	if delay == 0 {
+		return nil
+	}
+
+	if err := sleep(ctx, delay); err != nil {
+		// Give back the token that was reserved but not used.
+		l.mu.Lock()
+		l.tokens++
+		l.mu.Unlock()
+		return err
+	}
+
+	return nil
+}
+
+// update adapts the pace of the limiter to the budget reported in res.
+func (l *rateLimiter) update(res *http.Response) {
+	rl, ok := parseRateLimit(res.Header)
+	if !ok {
+		return
+	}
+
+	perMinute := l.perMinute
+	if float64(rl.Limit) < perMinute {
+		perMinute = float64(rl.Limit)
+	}
+
+	fraction := float64(rl.Remaining) / float64(rl.Limit)
+	if fraction < minRateFraction {
+		fraction = minRateFraction
+	}
+
+	l.mu.Lock()
+	l.rate = perMinute / 60 * fraction
+	l.mu.Unlock()
+}
+
+// withRateLimitRetry calls fn until it succeeds or fails with an error other
+// than a rate limit, waiting for the delay given by Zendesk between the calls.
+// It gives up after maxRateLimitRetries retries, or when the delay would run
+// past the deadline of ctx. It's used by the helpers that send many requests,
+// on top of the retries of the client.
+func withRateLimitRetry(ctx context.Context, fn func() error) error {
+	for retry := 0; ; retry++ {
+		err := fn()
+		if !IsRateLimited(err) || retry >= maxRateLimitRetries {
+			return err
+		}
+
+		delay := defaultRetryAfter
+		var apiErr *APIError
+		if errors.As(err, &apiErr) && apiErr.Response != nil {
+			if after := retryAfter(apiErr.Response); after > 0 {
+				delay = after
+			}
+		}
+
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+			return err
+		}
+
+		if err := sleep(ctx, delay); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package zendesk
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiterPacesRequests(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client1, err := NewURLClient(server.URL, "", "", WithRateLimit(1200))
+	require.NoError(t, err)
+
+	// a client returned by WithHeader shares the same budget
+	client2 := client1.WithHeader("foo", "bar")
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		_, err = client1.ShowLocale(1)
+		require.NoError(t, err)
+		_, err = client2.ShowLocale(1)
+		require.NoError(t, err)
+	}
+
+	// 6 requests at 20 requests per second need at least 250ms after the first one
+	require.True(t, time.Since(start) >= 250*time.Millisecond)
+}
+
+func TestRateLimiterAdaptsToHeaders(t *testing.T) {
+	limiter := newRateLimiter(600)
+	require.Equal(t, 10.0, limiter.rate)
+
+	res := &http.Response{Header: http.Header{}}
+	res.Header.Set("X-Rate-Limit", "400")
+	res.Header.Set("X-Rate-Limit-Remaining", "200")
+	limiter.update(res)
+	require.InDelta(t, 400.0/60/2, limiter.rate, 1e-9)
+
+	res.Header.Set("X-Rate-Limit-Remaining", "0")
+	limiter.update(res)
+	require.InDelta(t, 400.0/60*minRateFraction, limiter.rate, 1e-9)
+}
+
+func TestRateLimiterHonorsContext(t *testing.T) {
+	limiter := newRateLimiter(1)
+	require.NoError(t, limiter.wait(context.Background()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	require.Equal(t, context.DeadlineExceeded, limiter.wait(ctx))
+}
+
+func TestRateLimiterBurst(t *testing.T) {
+	limiter := newBurstRateLimiter(60, 3)
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		require.NoError(t, limiter.wait(context.Background()))
+	}
+	require.Less(t, time.Since(start), 100*time.Millisecond, "expected the burst to go out at once")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	require.Equal(t, context.DeadlineExceeded, limiter.wait(ctx))
+}
+
+func TestRateLimiterWaitsBeforeAuth(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	var fetched []time.Time
+	source := TokenSourceFunc(func(context.Context) (string, error) {
+		fetched = append(fetched, time.Now())
+		return "token", nil
+	})
+
+	client, err := NewURLClient(server.URL, "", "", WithRateLimit(600), WithTokenSource(source))
+	require.NoError(t, err)
+
+	start := time.Now()
+	for i := 0; i < 2; i++ {
+		_, err = client.ShowLocale(1)
+		require.NoError(t, err)
+	}
+
+	// the second token is only fetched once the limiter let the request through
+	require.Len(t, fetched, 2)
+	require.GreaterOrEqual(t, fetched[1].Sub(start), 90*time.Millisecond)
+}
+
+func TestWithRateLimitRetryHonorsDeadline(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	calls := 0
+	rateLimited := &APIError{Response: &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{}}}
+
+	start := time.Now()
+	err := withRateLimitRetry(ctx, func() error {
+		calls++
+		return rateLimited
+	})
+
+	// assert that it gives up at once rather than waiting past the deadline
+	require.Equal(t, rateLimited, err)
+	require.Equal(t, 1, calls)
+	require.Less(t, time.Since(start), 500*time.Millisecond)
+}
@@ -0,0 +1,92 @@
+package zendesk
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Response holds the metadata of the HTTP response to an API call.
+type Response struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+	// Header holds the response headers.
+	Header http.Header
+	// RequestID is the value of the X-Request-Id header set by Zendesk.
+	RequestID string
+	// RateLimit is the rate-limit budget reported by Zendesk.
+	RateLimit RateLimit
+	// Attempts is the number of times the request was sent, including retries.
+	Attempts int
+}
+
+// RateLimit represents the rate-limit budget reported in the response headers.
+//
+// Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/support/usage_limits
+type RateLimit struct {
+	// Limit is the number of requests allowed per minute.
+	Limit int
+	// Remaining is the number of requests left in the current window.
+	Remaining int
+	// Reset is when the current window ends, or the zero time if unknown.
+	Reset time.Time
+}
+
+type responseKey struct{}
+
+// CaptureResponse returns a copy of ctx that makes the client store the
+// metadata of the response into res. It's meant for the context-aware variants
+// of the Client methods:
+//
+//	var res zendesk.Response
+//	ticket, err := client.ShowTicketContext(zendesk.CaptureResponse(ctx, &res), id)
+//	log.Printf("%d requests left", res.RateLimit.Remaining)
+func CaptureResponse(ctx context.Context, res *Response) context.Context {
+	return context.WithValue(ctx, responseKey{}, res)
+}
+
+// captureResponse stores the metadata of res into the Response attached to ctx, if any.
+func captureResponse(ctx context.Context, res *http.Response, attempts int) {
+	out, ok := ctx.Value(responseKey{}).(*Response)
+	if !ok || out == nil || res == nil {
+		return
+	}
+
+	*out = Response{
+		StatusCode: res.StatusCode,
+		Header:     res.Header,
+		RequestID:  res.Header.Get("X-Request-Id"),
+		Attempts:   attempts,
+	}
+
+	if rl, ok := parseRateLimit(res.Header); ok {
+		out.RateLimit = rl
+	}
+}
+
+// parseRateLimit reads the rate-limit headers. It reports false when the
+// budget isn't included in the headers.
+func parseRateLimit(h http.Header) (RateLimit, bool) {
+	limit, err := strconv.Atoi(h.Get("X-Rate-Limit"))
+	if err != nil || limit <= 0 {
+		return RateLimit{}, false
+	}
+
+	remaining, err := strconv.Atoi(h.Get("X-Rate-Limit-Remaining"))
+	if err != nil || remaining < 0 {
+		return RateLimit{}, false
+	}
+
+	rl := RateLimit{Limit: limit, Remaining: remaining}
+
+	reset := h.Get("Ratelimit-Reset")
+	if reset == "" {
+		reset = h.Get("Retry-After")
+	}
+	if seconds, err := strconv.ParseInt(reset, 10, 64); err == nil && seconds >= 0 {
+		rl.Reset = time.Now().Add(time.Duration(seconds) * time.Second)
+	}
+
+	return rl, true
+}
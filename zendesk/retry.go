@@ -0,0 +1,274 @@
+package zendesk
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RetryPolicy describes when and how a failed request is retried.
+//
+// Zero values fall back to the corresponding field of DefaultRetryPolicy,
+// except for Jitter, MaxBackoff and RetryPOST whose zero values mean
+// "no jitter", "no cap" and "never retry POST requests".
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	MaxAttempts int
+	// InitialBackoff is the wait before the first retry.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the wait between two attempts.
+	MaxBackoff time.Duration
+	// Multiplier is the factor the wait grows by after each retry.
+	Multiplier float64
+	// Jitter randomizes each wait by up to this fraction of it, between 0 and 1.
+	Jitter float64
+	// RetryStatusCodes lists the response status codes that are retried.
+	RetryStatusCodes []int
+	// RetryPOST allows non-idempotent POST requests to be retried. Their
+	// bodies, such as the streams of UploadFile, are only buffered when set.
+	RetryPOST bool
+
+	// retryAfterOnly restricts retries to responses carrying a Retry-After header.
+	retryAfterOnly bool
+}
+
+// DefaultRetryPolicy provides the values used for the zero fields of a policy
+// passed to WithRetryPolicy.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    3,
+	InitialBackoff: 500 * time.Millisecond,
+	MaxBackoff:     30 * time.Second,
+	Multiplier:     2,
+	Jitter:         0.2,
+	RetryStatusCodes: []int{
+		http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout,
+	},
+}
+
+// legacyRetryPolicy is used by clients created without WithRetryPolicy: it
+// retries once, and only when the response carries a Retry-After header.
+// Bodies that can't be rewound are streamed and never retried under it.
+var legacyRetryPolicy = RetryPolicy{
+	MaxAttempts:    2,
+	RetryPOST:      true,
+	retryAfterOnly: true,
+}
+
+// WithRetryPolicy sets the policy used to retry failed requests.
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(c *client) {
+		if policy.MaxAttempts <= 0 {
+			policy.MaxAttempts = DefaultRetryPolicy.MaxAttempts
+		}
+		if policy.InitialBackoff <= 0 {
+			policy.InitialBackoff = DefaultRetryPolicy.InitialBackoff
+		}
+		if policy.Multiplier < 1 {
+			policy.Multiplier = DefaultRetryPolicy.Multiplier
+		}
+		if policy.RetryStatusCodes == nil {
+			policy.RetryStatusCodes = DefaultRetryPolicy.RetryStatusCodes
+		}
+		c.retry = &policy
+	}
+}
+
+func (c *client) retryPolicy() *RetryPolicy {
+	if c.retry == nil {
+		return &legacyRetryPolicy
+	}
+	return c.retry
+}
+
+// shouldRetry reports whether a request that ended with res or err may be sent again.
+func (p *RetryPolicy) shouldRetry(ctx context.Context, method string, res *http.Response, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	if p.retryAfterOnly {
+		return res != nil && retryAfter(res) > 0
+	}
+
+	if method == http.MethodPost && !p.RetryPOST {
+		return false
+	}
+
+	if res == nil {
+		return err != nil
+	}
+
+	for _, code := range p.RetryStatusCodes {
+		if res.StatusCode == code {
+			return true
+		}
+	}
+
+	return false
+}
+
+// backoff returns the wait before the retry following the given attempt.
+// The jitter is drawn from rnd.
+func (p *RetryPolicy) backoff(attempt int, res *http.Response, rnd *jitterSource) time.Duration {
+	if res != nil {
+		if after := retryAfter(res); after > 0 {
+			return after
+		}
+	}
+
+	wait := float64(p.InitialBackoff) * math.Pow(p.Multiplier, float64(attempt-1))
+	if p.MaxBackoff > 0 && wait > float64(p.MaxBackoff) {
+		wait = float64(p.MaxBackoff)
+	}
+
+	if p.Jitter > 0 {
+		wait += wait * p.Jitter * (2*rnd.float64() - 1)
+	}
+
+	return time.Duration(wait)
+}
+
+// retryAfter returns the wait requested by the Retry-After header of res, if
+// any. The header holds either a number of seconds or an HTTP date.
+func retryAfter(res *http.Response) time.Duration {
+	value := res.Header.Get("Retry-After")
+	if value == "" {
+		return 0
+	}
+
+	if after, err := strconv.ParseInt(value, 10, 64); err == nil {
+		if after <= 0 {
+			return 0
+		}
+		return time.Duration(after) * time.Second
+	}
+
+	at, err := http.ParseTime(value)
+	if err != nil {
+		return 0
+	}
+	if wait := time.Until(at); wait > 0 {
+		return wait
+	}
+	return 0
+}
+
+// jitterSource draws the jitter of the retries of a client. It's shared by the
+// clients returned by WithHeader, hence the lock.
+type jitterSource struct {
+	mu  sync.Mutex
+	rnd *rand.Rand
+}
+
+func newJitterSource() *jitterSource {
+	return &jitterSource{rnd: rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64()))}
+}
+
+// float64 returns a number in [0, 1). A nil source draws no jitter.
+func (j *jitterSource) float64() float64 {
+	if j == nil {
+		return 0.5
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.rnd.Float64()
+}
+
+// send performs a request and retries it according to the client's retry policy.
+func (c *client) send(ctx context.Context, op, method string, rt route, headers map[string]string, body io.Reader) (*http.Response, error) {
+	policy := c.retryPolicy()
+	maxAttempts := policy.MaxAttempts
+	if method == http.MethodPost && !policy.RetryPOST && !policy.retryAfterOnly {
+		// shouldRetry never retries the request, don't buffer its body for nothing.
+		maxAttempts = 1
+	}
+
+	rewind := func() error { return nil }
+	if maxAttempts > 1 {
+		// Only buffer the bodies of the clients that opted into retries.
+		var replay bool
+		var err error
+		if body, rewind, replay, err = replayable(body, c.retry != nil); err != nil {
+			return nil, err
+		}
+		if !replay {
+			maxAttempts = 1
+		}
+	}
+
+	for attempt := 1; ; attempt++ {
+		res, err := c.request(ctx, op, method, rt, headers, body, attempt)
+		if attempt >= maxAttempts || !policy.shouldRetry(ctx, method, res, err) {
+			captureResponse(ctx, res, attempt)
+			return res, err
+		}
+
+		wait := policy.backoff(attempt, res, c.jitter)
+		if res != nil {
+			io.Copy(io.Discard, res.Body)
+			res.Body.Close()
+		}
+
+		if err := sleep(ctx, wait); err != nil {
+			return nil, err
+		}
+
+		if err := rewind(); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// replayable returns a reader equivalent to body along with a function that
+// rewinds it to its initial position, so the body can be sent more than once.
+// Seekable bodies are rewound in place. Anything else is buffered in memory when
+// buffer is set, and is otherwise returned as is and reported as not replayable.
+func replayable(body io.Reader, buffer bool) (io.Reader, func() error, bool, error) {
+	if body == nil {
+		return nil, func() error { return nil }, true, nil
+	}
+
+	if r, ok := body.(*bytes.Reader); ok {
+		return r, func() error {
+			_, err := r.Seek(0, io.SeekStart)
+			return err
+		}, true, nil
+	}
+
+	if s, ok := body.(io.ReadSeeker); ok {
+		offset, err := s.Seek(0, io.SeekCurrent)
+		if err == nil {
+			// Hide any Close method so the transport leaves the body open for the next attempt.
+			return struct{ io.Reader }{s}, func() error {
+				_, err := s.Seek(offset, io.SeekStart)
+				return err
+			}, true, nil
+		}
+	}
+
+	if !buffer {
+		return body, func() error { return nil }, false, nil
+	}
+
+	buf, err := io.ReadAll(body)
+	if err != nil {
+		return nil, nil, false, err
+	}
+
+	r := bytes.NewReader(buf)
+	return r, func() error {
+		_, err := r.Seek(0, io.SeekStart)
+		return err
+	}, true, nil
+}
@@ -0,0 +1,182 @@
+package zendesk_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/MEDIGO/go-zendesk/zendesk"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryPolicyRetriesServerErrors(t *testing.T) {
+	var attempts int32
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"locale":{"id":1}}`))
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(handler))
+	defer server.Close()
+
+	client, err := zendesk.NewURLClient(server.URL, "", "", zendesk.WithRetryPolicy(zendesk.RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+	}))
+	require.NoError(t, err)
+
+	locale, err := client.ShowLocale(1)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), *locale.ID)
+	require.Equal(t, int32(3), atomic.LoadInt32(&attempts))
+}
+
+func TestRetryPolicySkipsPOSTByDefault(t *testing.T) {
+	var attempts int32
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusBadGateway)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(handler))
+	defer server.Close()
+
+	client, err := zendesk.NewURLClient(server.URL, "", "", zendesk.WithRetryPolicy(zendesk.RetryPolicy{
+		InitialBackoff: time.Millisecond,
+	}))
+	require.NoError(t, err)
+
+	_, err = client.CreateTicket(&zendesk.Ticket{Subject: zendesk.String("subject")})
+	require.Error(t, err)
+	require.Equal(t, int32(1), atomic.LoadInt32(&attempts))
+}
+
+func TestRetryPolicyReplaysUploadStream(t *testing.T) {
+	var bodies []string
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		if len(bodies) == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Write([]byte(`{"upload":{"token":"abc"}}`))
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(handler))
+	defer server.Close()
+
+	client, err := zendesk.NewURLClient(server.URL, "", "", zendesk.WithRetryPolicy(zendesk.RetryPolicy{
+		InitialBackoff: time.Millisecond,
+		RetryPOST:      true,
+	}))
+	require.NoError(t, err)
+
+	// wrap the reader so it can't be rewound by seeking
+	content := io.NopCloser(strings.NewReader("file content"))
+
+	upload, err := client.UploadFile("file.txt", nil, content)
+	require.NoError(t, err)
+	require.Equal(t, "abc", *upload.Token)
+	require.Equal(t, []string{"file content", "file content"}, bodies)
+}
+
+func TestDefaultClientStreamsUploadWithoutRetrying(t *testing.T) {
+	var bodies []string
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		w.Header().Set("Retry-After", "1")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(handler))
+	defer server.Close()
+
+	client, err := zendesk.NewURLClient(server.URL, "", "")
+	require.NoError(t, err)
+
+	content := io.NopCloser(strings.NewReader("file content"))
+
+	_, err = client.UploadFile("file.txt", nil, content)
+	require.Error(t, err)
+	require.Equal(t, []string{"file content"}, bodies, "expected a non-seekable body to be sent once")
+}
+
+func TestRetryPolicyStreamsPOSTWithoutRetryPOST(t *testing.T) {
+	received := make(chan struct{})
+	var body string
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		head := make([]byte, 5)
+		io.ReadFull(r.Body, head)
+		close(received)
+
+		rest, _ := io.ReadAll(r.Body)
+		body = string(head) + string(rest)
+		w.Write([]byte(`{"upload":{"token":"abc"}}`))
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(handler))
+	defer server.Close()
+
+	client, err := zendesk.NewURLClient(server.URL, "", "", zendesk.WithRetryPolicy(zendesk.DefaultRetryPolicy))
+	require.NoError(t, err)
+
+	// the rest of the body is only written once the server got its start,
+	// which never happens if the client buffers the body before sending it
+	content, w := io.Pipe()
+	streamed := make(chan bool, 1)
+	go func() {
+		w.Write([]byte("first"))
+		select {
+		case <-received:
+			streamed <- true
+		case <-time.After(5 * time.Second):
+			streamed <- false
+		}
+		w.Write([]byte(" second"))
+		w.Close()
+	}()
+
+	upload, err := client.UploadFile("file.txt", nil, content)
+	require.NoError(t, err)
+	require.Equal(t, "abc", *upload.Token)
+	require.True(t, <-streamed, "expected the body to be streamed")
+	require.Equal(t, "first second", body)
+}
+
+func TestRetryAfterHTTPDate(t *testing.T) {
+	var attempts int32
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			w.Header().Set("Retry-After", time.Now().Add(2*time.Second).UTC().Format(http.TimeFormat))
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte(`{"user":{"id":1}}`))
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(handler))
+	defer server.Close()
+
+	client, err := zendesk.NewURLClient(server.URL, "", "")
+	require.NoError(t, err)
+
+	user, err := client.ShowUser(1)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), *user.ID)
+	require.Equal(t, int32(2), atomic.LoadInt32(&attempts))
+}
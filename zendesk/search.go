@@ -1,7 +1,10 @@
 package zendesk
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/url"
 	"strconv"
 	"strings"
 
@@ -68,33 +71,42 @@ func OrganizationFilter(organizationID int) Filters {
 // GroupNameFilter filters results by their group name
 func GroupNameFilter(name string) Filters {
 	return func(c *QueryOptions) {
-		if strings.Contains(name, " ") {
-			name = fmt.Sprintf("\"%s\"", name)
-		}
-		c.Search = append(c.Search, fmt.Sprintf("group:%s", name))
+		c.Search = append(c.Search, fmt.Sprintf("group:%s", quoteSearchValue(name)))
 	}
 }
 
-// SearchTickets leverages the unified search api to return tickets
+// searchQuery renders the query of a search for records of type t. The term is
+// a query of its own, such as the one rendered by a Query, and is sent as is.
+func searchQuery(t ResultType, term string, filters ...Filters) string {
+	searchOptions := &QueryOptions{}
+	for _, opt := range filters {
+		opt(searchOptions)
+	}
+
+	terms := append([]string{fmt.Sprintf("type:%s", t)}, searchOptions.Search...)
+	if term != "" {
+		terms = append(terms, term)
+	}
+	return strings.Join(terms, " ")
+}
+
+// SearchTickets leverages the unified search api to return tickets. The term
+// is sent as is, use a Query to build it.
 //
 // Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/support/search
 func (c *client) SearchTickets(term string, options *ListOptions, filters ...Filters) (*TicketSearchResults, error) {
+	return c.SearchTicketsContext(context.Background(), term, options, filters...)
+}
+
+// SearchTicketsContext is like SearchTickets but uses ctx for the underlying request.
+func (c *client) SearchTicketsContext(ctx context.Context, term string, options *ListOptions, filters ...Filters) (*TicketSearchResults, error) {
 	params, err := query.Values(options)
 	if err != nil {
 		return nil, err
 	}
-	searchOptions := &QueryOptions{}
-	for _, opt := range filters {
-		opt(searchOptions)
-	}
-	queryString := fmt.Sprintf("type:%s ", ResultTypeTicket)
-	queryString += strings.Join(searchOptions.Search, " ")
-	if term != "" {
-		queryString = fmt.Sprintf(`%s /"%s/"`, queryString, term)
-	}
-	params.Set("query", queryString)
+	params.Set("query", searchQuery(ResultTypeTicket, term, filters...))
 	out := new(TicketSearchResults)
-	err = c.get(fmt.Sprintf("/api/v2/search.json?%s", params.Encode()), out)
+	err = c.get(ctx, "SearchTickets", routef("/api/v2/search.json?%s", params.Encode()), out)
 	if err != nil {
 		return nil, err
 	}
@@ -105,24 +117,234 @@ func (c *client) SearchTickets(term string, options *ListOptions, filters ...Fil
 //
 // Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/support/search
 func (c *client) SearchUsersEx(term string, options *ListOptions, filters ...Filters) (*UserSearchResults, error) {
+	return c.SearchUsersExContext(context.Background(), term, options, filters...)
+}
+
+// SearchUsersExContext is like SearchUsersEx but uses ctx for the underlying request.
+func (c *client) SearchUsersExContext(ctx context.Context, term string, options *ListOptions, filters ...Filters) (*UserSearchResults, error) {
 	params, err := query.Values(options)
 	if err != nil {
 		return nil, err
 	}
-	searchOptions := &QueryOptions{}
-	for _, opt := range filters {
-		opt(searchOptions)
+	params.Set("query", searchQuery(ResultTypeUser, term, filters...))
+	out := new(UserSearchResults)
+	err = c.get(ctx, "SearchUsersEx", routef("/api/v2/search.json?%s", params.Encode()), out)
+	if err != nil {
+		return nil, err
 	}
-	queryString := fmt.Sprintf("type:%s ", ResultTypeUser)
-	queryString += strings.Join(searchOptions.Search, " ")
-	if term != "" {
-		queryString = fmt.Sprintf(`%s %s`, queryString, term)
+	return out, nil
+}
+
+// SearchOrganizations leverages the unified search api to return organizations
+//
+// Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/support/search
+func (c *client) SearchOrganizations(term string, options *ListOptions, filters ...Filters) (*OrganizationSearchResults, error) {
+	return c.SearchOrganizationsContext(context.Background(), term, options, filters...)
+}
+
+// SearchOrganizationsContext is like SearchOrganizations but uses ctx for the underlying request.
+func (c *client) SearchOrganizationsContext(ctx context.Context, term string, options *ListOptions, filters ...Filters) (*OrganizationSearchResults, error) {
+	params, err := query.Values(options)
+	if err != nil {
+		return nil, err
 	}
-	params.Set("query", queryString)
-	out := new(UserSearchResults)
-	err = c.get(fmt.Sprintf("/api/v2/search.json?%s", params.Encode()), out)
+	params.Set("query", searchQuery(ResultTypeOrganization, term, filters...))
+	out := new(OrganizationSearchResults)
+	err = c.get(ctx, "SearchOrganizations", routef("/api/v2/search.json?%s", params.Encode()), out)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
+
+// SearchGroups leverages the unified search api to return groups
+//
+// Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/support/search
+func (c *client) SearchGroups(term string, options *ListOptions, filters ...Filters) (*GroupSearchResults, error) {
+	return c.SearchGroupsContext(context.Background(), term, options, filters...)
+}
+
+// SearchGroupsContext is like SearchGroups but uses ctx for the underlying request.
+func (c *client) SearchGroupsContext(ctx context.Context, term string, options *ListOptions, filters ...Filters) (*GroupSearchResults, error) {
+	params, err := query.Values(options)
+	if err != nil {
+		return nil, err
+	}
+	params.Set("query", searchQuery(ResultTypeGroup, term, filters...))
+	out := new(GroupSearchResults)
+	err = c.get(ctx, "SearchGroups", routef("/api/v2/search.json?%s", params.Encode()), out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SearchResult is a record returned by an untyped search. ResultType tells
+// which of the record fields is set. Records of other types are only
+// available through Raw.
+type SearchResult struct {
+	ResultType   ResultType
+	Ticket       *Ticket
+	User         *User
+	Organization *Organization
+	Group        *Group
+	// Raw holds the undecoded record.
+	Raw json.RawMessage
+}
+
+// UnmarshalJSON decodes the record according to its result_type.
+func (r *SearchResult) UnmarshalJSON(data []byte) error {
+	var head struct {
+		ResultType ResultType `json:"result_type"`
+	}
+	if err := json.Unmarshal(data, &head); err != nil {
+		return err
+	}
+
+	return r.decode(head.ResultType, data)
+}
+
+// decode decodes data as a record of type t.
+func (r *SearchResult) decode(t ResultType, data []byte) error {
+	*r = SearchResult{ResultType: t, Raw: append(json.RawMessage(nil), data...)}
+
+	var record interface{}
+	switch t {
+	case ResultTypeTicket:
+		r.Ticket = new(Ticket)
+		record = r.Ticket
+	case ResultTypeUser:
+		r.User = new(User)
+		record = r.User
+	case ResultTypeOrganization:
+		r.Organization = new(Organization)
+		record = r.Organization
+	case ResultTypeGroup:
+		r.Group = new(Group)
+		record = r.Group
+	default:
+		return nil
+	}
+	return json.Unmarshal(data, record)
+}
+
+// MarshalJSON encodes the record field that is set along with its
+// result_type, so that changes to the record are kept. Records of other types
+// are encoded from Raw.
+func (r SearchResult) MarshalJSON() ([]byte, error) {
+	var record interface{}
+	var t ResultType
+	switch {
+	case r.Ticket != nil:
+		record, t = r.Ticket, ResultTypeTicket
+	case r.User != nil:
+		record, t = r.User, ResultTypeUser
+	case r.Organization != nil:
+		record, t = r.Organization, ResultTypeOrganization
+	case r.Group != nil:
+		record, t = r.Group, ResultTypeGroup
+	default:
+		if r.Raw == nil {
+			return []byte("null"), nil
+		}
+		return r.Raw, nil
+	}
+
+	data, err := json.Marshal(record)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	if fields == nil {
+		fields = make(map[string]json.RawMessage)
+	}
+
+	fields["result_type"], err = json.Marshal(t)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(fields)
+}
+
+// Search leverages the unified search api to return records of any type. The
+// query is sent as is, use a Query to build it.
+//
+// Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/support/search
+func (c *client) Search(q string, options *ListOptions) (*SearchResults, error) {
+	return c.SearchContext(context.Background(), q, options)
+}
+
+// SearchContext is like Search but uses ctx for the underlying request.
+func (c *client) SearchContext(ctx context.Context, q string, options *ListOptions) (*SearchResults, error) {
+	params, err := query.Values(options)
+	if err != nil {
+		return nil, err
+	}
+	params.Set("query", q)
+	out := new(SearchResults)
+	err = c.get(ctx, "Search", routef("/api/v2/search.json?%s", params.Encode()), out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SearchExport leverages the search export api to return all the records of
+// type t matching the query, past the 1000 results the unified search api is
+// limited to. The pages are fetched with cursor pagination.
+//
+// Zendesk Core API docs: https://developer.zendesk.com/api-reference/ticketing/ticket-management/search/#export-search-results
+func (c *client) SearchExport(q string, t ResultType, options *CursorOptions) (*SearchExportResults, error) {
+	return c.SearchExportContext(context.Background(), q, t, options)
+}
+
+// SearchExportContext is like SearchExport but uses ctx for the underlying request.
+func (c *client) SearchExportContext(ctx context.Context, q string, t ResultType, options *CursorOptions) (*SearchExportResults, error) {
+	params, err := query.Values(options)
+	if err != nil {
+		return nil, err
+	}
+	params.Set("query", q)
+	params.Set("filter[type]", string(t))
+	out := new(SearchExportResults)
+	err = c.get(ctx, "SearchExport", routef("/api/v2/search/export.json?%s", params.Encode()), out)
+	if err != nil {
+		return nil, err
+	}
+
+	// The results are all of the filtered type, whether or not they report it.
+	for i := range out.Results {
+		if result := &out.Results[i]; result.ResultType == "" {
+			if err := result.decode(t, result.Raw); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return out, nil
+}
+
+// CountSearch returns the number of records matching the query.
+//
+// Zendesk Core API docs: https://developer.zendesk.com/api-reference/ticketing/ticket-management/search/#show-results-count
+func (c *client) CountSearch(q string) (int64, error) {
+	return c.CountSearchContext(context.Background(), q)
+}
+
+// CountSearchContext is like CountSearch but uses ctx for the underlying request.
+func (c *client) CountSearchContext(ctx context.Context, q string) (int64, error) {
+	params := url.Values{}
+	params.Set("query", q)
+	out := new(APIPayload)
+	err := c.get(ctx, "CountSearch", routef("/api/v2/search/count.json?%s", params.Encode()), out)
+	if err != nil {
+		return 0, err
+	}
+	if out.Count == nil {
+		return 0, nil
+	}
+	return *out.Count, nil
+}
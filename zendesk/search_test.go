@@ -0,0 +1,136 @@
+package zendesk_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/MEDIGO/go-zendesk/zendesk"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchMixedResults(t *testing.T) {
+	var query string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query().Get("query")
+		fmt.Fprint(w, `{"results":[
+			{"result_type":"ticket","id":1,"subject":"Help"},
+			{"result_type":"user","id":2,"name":"Jane"},
+			{"result_type":"organization","id":3,"name":"Acme"},
+			{"result_type":"group","id":4,"name":"Support"},
+			{"result_type":"entry","id":5,"title":"Forum"}
+		],"next_page":null,"count":5}`)
+	}))
+	defer server.Close()
+
+	client, err := zendesk.NewURLClient(server.URL, "", "")
+	require.NoError(t, err)
+
+	results, err := client.Search(zendesk.NewQuery().Text("acme").String(), nil)
+	require.NoError(t, err)
+	require.Equal(t, "acme", query)
+	require.Len(t, results.Results, 5)
+
+	require.Equal(t, "Help", *results.Results[0].Ticket.Subject)
+	require.Equal(t, "Jane", *results.Results[1].User.Name)
+	require.Equal(t, "Acme", *results.Results[2].Organization.Name)
+	require.Equal(t, "Support", *results.Results[3].Group.Name)
+
+	other := results.Results[4]
+	require.Equal(t, zendesk.ResultType("entry"), other.ResultType)
+	require.Nil(t, other.Ticket)
+	require.JSONEq(t, `{"result_type":"entry","id":5,"title":"Forum"}`, string(other.Raw))
+
+	data, err := json.Marshal(other)
+	require.NoError(t, err)
+	require.JSONEq(t, string(other.Raw), string(data))
+
+	// assert that the typed record is encoded, including the changes made to it
+	ticket := results.Results[0]
+	ticket.Ticket.Subject = zendesk.String("Help needed")
+	data, err = json.Marshal(ticket)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"result_type":"ticket","id":1,"subject":"Help needed"}`, string(data))
+
+	data, err = json.Marshal(zendesk.SearchResult{User: &zendesk.User{ID: zendesk.Int(2)}})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"result_type":"user","id":2}`, string(data))
+}
+
+func TestSearchOrganizationsAndGroups(t *testing.T) {
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.Query().Get("query"))
+		fmt.Fprint(w, `{"results":[{"id":1,"name":"Acme"}],"count":1}`)
+	}))
+	defer server.Close()
+
+	client, err := zendesk.NewURLClient(server.URL, "", "")
+	require.NoError(t, err)
+
+	orgs, err := client.SearchOrganizations("acme", nil)
+	require.NoError(t, err)
+	require.Equal(t, "Acme", *orgs.Results[0].Name)
+
+	groups, err := client.SearchGroups("", nil, zendesk.NewQuery().Text("Acme").Filter())
+	require.NoError(t, err)
+	require.Equal(t, "Acme", *groups.Results[0].Name)
+
+	require.Equal(t, []string{"type:organization acme", "type:group Acme"}, queries)
+}
+
+func TestSearchExport(t *testing.T) {
+	var requested []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = append(requested, r.URL.RawQuery)
+		if r.URL.Query().Get("page[after]") == "" {
+			fmt.Fprint(w, `{"results":[{"id":1,"result_type":"ticket"},{"id":2}],"meta":{"has_more":true,"after_cursor":"abc"},"links":{"next":"next"}}`)
+			return
+		}
+		fmt.Fprint(w, `{"results":[{"id":3}],"meta":{"has_more":false},"links":{"next":null}}`)
+	}))
+	defer server.Close()
+
+	client, err := zendesk.NewURLClient(server.URL, "", "")
+	require.NoError(t, err)
+
+	options := &zendesk.CursorOptions{PageSize: 2}
+	var ids []int64
+	for {
+		page, err := client.SearchExport("status:open", zendesk.ResultTypeTicket, options)
+		require.NoError(t, err)
+		for _, result := range page.Results {
+			require.Equal(t, zendesk.ResultTypeTicket, result.ResultType)
+			ids = append(ids, *result.Ticket.ID)
+		}
+		if !page.Meta.HasMore {
+			break
+		}
+		options.After = *page.Meta.AfterCursor
+	}
+
+	require.Equal(t, []int64{1, 2, 3}, ids)
+	require.Equal(t, []string{
+		"filter%5Btype%5D=ticket&page%5Bsize%5D=2&query=status%3Aopen",
+		"filter%5Btype%5D=ticket&page%5Bafter%5D=abc&page%5Bsize%5D=2&query=status%3Aopen",
+	}, requested)
+}
+
+func TestCountSearch(t *testing.T) {
+	var requested string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = r.URL.RequestURI()
+		fmt.Fprint(w, `{"count":1234}`)
+	}))
+	defer server.Close()
+
+	client, err := zendesk.NewURLClient(server.URL, "", "")
+	require.NoError(t, err)
+
+	count, err := client.CountSearch("type:ticket status:open")
+	require.NoError(t, err)
+	require.Equal(t, int64(1234), count)
+	require.Equal(t, "/api/v2/search/count.json?query=type%3Aticket+status%3Aopen", requested)
+}
@@ -0,0 +1,204 @@
+package zendesk
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"slices"
+	"time"
+)
+
+const (
+	// maxSearchResults is the most results the unified search api returns for a query.
+	maxSearchResults = 1000
+	// searchPerPage is the page size used to fetch the windows of SearchAllTickets.
+	searchPerPage = 100
+	// defaultSearchConcurrency is the number of windows fetched at the same time by default.
+	defaultSearchConcurrency = 4
+)
+
+// searchEpoch is the default start of the windows of SearchAllTickets, before
+// any Zendesk account was created.
+var searchEpoch = time.Date(2007, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// WindowSearchOptions specifies the optional parameters of SearchAllTickets.
+type WindowSearchOptions struct {
+	// From and To bound the creation time of the tickets. They default to the
+	// launch of Zendesk and to the current time.
+	From, To time.Time
+	// Concurrency sets how many windows are fetched at the same time. Defaults to 4.
+	Concurrency int
+}
+
+// searchWindow is a range of creation times, From included and To excluded.
+type searchWindow struct {
+	From, To time.Time
+}
+
+func (w searchWindow) filter() Filters {
+	return NewQuery().
+		Created(GreaterThanOrEqualTo, Date(w.From)).
+		Created(LessThan, Date(w.To)).
+		Filter()
+}
+
+// SearchAllTickets leverages SearchTickets to return all the tickets matching
+// the term and filters, past the 1000 results the unified search api is limited
+// to. The query is split into windows of creation time, halved until each of
+// them matches at most 1000 tickets.
+//
+// The tickets are yielded in creation order, without duplicates. The windows
+// are fetched with bounded concurrency, waiting out the rate limits reported by
+// Zendesk. Iteration stops after yielding the first error.
+//
+// Prefer SearchExport when it's available to the account.
+func (c *client) SearchAllTickets(ctx context.Context, term string, options *WindowSearchOptions, filters ...Filters) iter.Seq2[Ticket, error] {
+	opts := WindowSearchOptions{}
+	if options != nil {
+		opts = *options
+	}
+	if opts.From.IsZero() {
+		opts.From = searchEpoch
+	}
+	if opts.To.IsZero() {
+		opts.To = time.Now()
+	}
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = defaultSearchConcurrency
+	}
+
+	return func(yield func(Ticket, error) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		windows, err := c.splitSearchWindows(ctx, term, filters, searchWindow{
+			From: opts.From.Truncate(time.Second),
+			To:   opts.To.Truncate(time.Second).Add(time.Second),
+		})
+		if err != nil {
+			yield(Ticket{}, err)
+			return
+		}
+
+		type windowResult struct {
+			tickets []Ticket
+			err     error
+		}
+
+		results := make([]chan windowResult, len(windows))
+		for i := range results {
+			results[i] = make(chan windowResult, 1)
+		}
+
+		// Windows are started in order, and a slot is only freed once the window
+		// has been yielded, which bounds both the requests and the buffered tickets.
+		sem := make(chan struct{}, opts.Concurrency)
+		go func() {
+			for i, window := range windows {
+				select {
+				case sem <- struct{}{}:
+				case <-ctx.Done():
+					return
+				}
+
+				go func(i int, window searchWindow) {
+					tickets, err := c.searchWindowTickets(ctx, term, filters, window)
+					results[i] <- windowResult{tickets, err}
+				}(i, window)
+			}
+		}()
+
+		seen := make(map[int64]bool)
+		for i := range windows {
+			var result windowResult
+			select {
+			case result = <-results[i]:
+			case <-ctx.Done():
+				yield(Ticket{}, ctx.Err())
+				return
+			}
+			<-sem
+
+			if result.err != nil {
+				yield(Ticket{}, result.err)
+				return
+			}
+
+			for _, ticket := range result.tickets {
+				if ticket.ID != nil {
+					if seen[*ticket.ID] {
+						continue
+					}
+					seen[*ticket.ID] = true
+				}
+
+				if !yield(ticket, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// splitSearchWindows halves window until each part matches at most
+// maxSearchResults tickets, and returns the parts that match any, in order.
+func (c *client) splitSearchWindows(ctx context.Context, term string, filters []Filters, window searchWindow) ([]searchWindow, error) {
+	var count int64
+	err := withRateLimitRetry(ctx, func() error {
+		res, err := c.SearchTicketsContext(ctx, term, &ListOptions{PerPage: 1}, append(slices.Clip(filters), window.filter())...)
+		if err == nil && res.Count != nil {
+			count = *res.Count
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if count == 0 {
+		return nil, nil
+	}
+
+	span := window.To.Sub(window.From)
+	if count <= maxSearchResults {
+		return []searchWindow{window}, nil
+	}
+	if span <= time.Second {
+		return nil, fmt.Errorf("zendesk: more than %d tickets created at %s", maxSearchResults, window.From.Format(time.RFC3339))
+	}
+
+	mid := window.From.Add(span / 2).Truncate(time.Second)
+	before, err := c.splitSearchWindows(ctx, term, filters, searchWindow{window.From, mid})
+	if err != nil {
+		return nil, err
+	}
+	after, err := c.splitSearchWindows(ctx, term, filters, searchWindow{mid, window.To})
+	if err != nil {
+		return nil, err
+	}
+	return append(before, after...), nil
+}
+
+// searchWindowTickets fetches all the pages of the tickets of window, in creation order.
+func (c *client) searchWindowTickets(ctx context.Context, term string, filters []Filters, window searchWindow) ([]Ticket, error) {
+	var tickets []Ticket
+
+	options := &ListOptions{PerPage: searchPerPage, SortBy: "created_at", SortOrder: "asc"}
+	for page := 1; ; page++ {
+		options.Page = page
+
+		var res *TicketSearchResults
+		err := withRateLimitRetry(ctx, func() (err error) {
+			res, err = c.SearchTicketsContext(ctx, term, options, append(slices.Clip(filters), window.filter())...)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		tickets = append(tickets, res.Results...)
+		if res.NextPage == nil || len(res.Results) == 0 {
+			return tickets, nil
+		}
+	}
+}
@@ -0,0 +1,176 @@
+package zendesk_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/MEDIGO/go-zendesk/zendesk"
+	"github.com/stretchr/testify/require"
+)
+
+// newTicketSearchServer serves the unified search api over the given number of
+// tickets, created ten seconds apart from base. Each page repeats the last
+// ticket of the previous one, like a search whose results shift while paging.
+func newTicketSearchServer(t *testing.T, base time.Time, total int) (*httptest.Server, *int) {
+	var mu sync.Mutex
+	var requests int
+
+	parse := func(value string) time.Time {
+		for _, layout := range []string{time.RFC3339, "2006-01-02"} {
+			if at, err := time.Parse(layout, value); err == nil {
+				return at
+			}
+		}
+		t.Fatalf("invalid date %q", value)
+		return time.Time{}
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests++
+		mu.Unlock()
+
+		var from, to time.Time
+		for _, term := range strings.Fields(r.URL.Query().Get("query")) {
+			switch {
+			case strings.HasPrefix(term, "created>="):
+				from = parse(strings.TrimPrefix(term, "created>="))
+			case strings.HasPrefix(term, "created<"):
+				to = parse(strings.TrimPrefix(term, "created<"))
+			}
+		}
+
+		var ids []int
+		for i := 0; i < total; i++ {
+			created := base.Add(time.Duration(i) * 10 * time.Second)
+			if !created.Before(from) && created.Before(to) {
+				ids = append(ids, i+1)
+			}
+		}
+
+		page, perPage := 1, 100
+		fmt.Sscan(r.URL.Query().Get("page"), &page)
+		fmt.Sscan(r.URL.Query().Get("per_page"), &perPage)
+
+		start, end := (page-1)*perPage, page*perPage
+		if start > 0 {
+			start--
+		}
+		if end > len(ids) {
+			end = len(ids)
+		}
+		if start > end {
+			start = end
+		}
+
+		results := make([]string, 0, end-start)
+		for _, id := range ids[start:end] {
+			results = append(results, fmt.Sprintf(`{"id":%d}`, id))
+		}
+
+		next := "null"
+		if end < len(ids) {
+			next = `"next"`
+		}
+		fmt.Fprintf(w, `{"results":[%s],"count":%d,"next_page":%s}`, strings.Join(results, ","), len(ids), next)
+	}))
+
+	return server, &requests
+}
+
+func TestSearchAllTickets(t *testing.T) {
+	base := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	server, _ := newTicketSearchServer(t, base, 2500)
+	defer server.Close()
+
+	client, err := zendesk.NewURLClient(server.URL, "", "")
+	require.NoError(t, err)
+
+	options := &zendesk.WindowSearchOptions{From: base, To: base.Add(24 * time.Hour), Concurrency: 3}
+
+	var ids []int64
+	for ticket, err := range client.SearchAllTickets(context.Background(), "status:open", options) {
+		require.NoError(t, err)
+		ids = append(ids, *ticket.ID)
+	}
+
+	require.Len(t, ids, 2500)
+	for i, id := range ids {
+		require.Equal(t, int64(i+1), id)
+	}
+}
+
+func TestSearchAllTicketsFiltersWithSpareCapacity(t *testing.T) {
+	base := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	server, _ := newTicketSearchServer(t, base, 2500)
+	defer server.Close()
+
+	client, err := zendesk.NewURLClient(server.URL, "", "")
+	require.NoError(t, err)
+
+	// the windows are fetched concurrently, each adding its filter to these
+	filters := make([]zendesk.Filters, 1, 8)
+	filters[0] = zendesk.StatusFilter(zendesk.StatusOpen, zendesk.Equality)
+
+	options := &zendesk.WindowSearchOptions{From: base, To: base.Add(24 * time.Hour), Concurrency: 3}
+
+	var ids []int64
+	for ticket, err := range client.SearchAllTickets(context.Background(), "", options, filters...) {
+		require.NoError(t, err)
+		ids = append(ids, *ticket.ID)
+	}
+
+	require.Len(t, ids, 2500)
+	for i, id := range ids {
+		require.Equal(t, int64(i+1), id)
+	}
+}
+
+func TestSearchAllTicketsStopsEarly(t *testing.T) {
+	base := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	server, requests := newTicketSearchServer(t, base, 2500)
+	defer server.Close()
+
+	client, err := zendesk.NewURLClient(server.URL, "", "")
+	require.NoError(t, err)
+
+	options := &zendesk.WindowSearchOptions{From: base, To: base.Add(24 * time.Hour), Concurrency: 1}
+
+	var count int
+	for _, err := range client.SearchAllTickets(context.Background(), "", options) {
+		require.NoError(t, err)
+		count++
+		if count == 10 {
+			break
+		}
+	}
+
+	require.Equal(t, 10, count)
+	require.Less(t, *requests, 30)
+}
+
+func TestSearchAllTicketsTooDense(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"results":[],"count":5000}`)
+	}))
+	defer server.Close()
+
+	client, err := zendesk.NewURLClient(server.URL, "", "")
+	require.NoError(t, err)
+
+	base := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	options := &zendesk.WindowSearchOptions{From: base, To: base.Add(time.Minute)}
+
+	var errs []error
+	for _, err := range client.SearchAllTickets(context.Background(), "", options) {
+		errs = append(errs, err)
+	}
+	require.Len(t, errs, 1)
+	require.ErrorContains(t, errs[0], "more than 1000 tickets")
+}
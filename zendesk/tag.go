@@ -0,0 +1,231 @@
+package zendesk
+
+import (
+	"context"
+	"net/url"
+)
+
+// Tag represents a Zendesk tag along with the number of times it's used.
+//
+// Zendesk Core API docs: https://developer.zendesk.com/api-reference/ticketing/ticket-management/tags/#list-tags
+type Tag struct {
+	Name  *string `json:"name,omitempty"`
+	Count *int64  `json:"count,omitempty"`
+}
+
+// tagsResponse is the response of ListTags, whose tags come with their count
+// rather than as plain names.
+type tagsResponse struct {
+	Tags []Tag `json:"tags"`
+}
+
+// tagSet is the payload of the requests replacing the tags of a resource.
+// Unlike APIPayload it keeps an empty list, which clears the tags.
+type tagSet struct {
+	Tags []string `json:"tags"`
+}
+
+func newTagSet(tags []string) *tagSet {
+	if tags == nil {
+		tags = []string{}
+	}
+	return &tagSet{Tags: tags}
+}
+
+// ListTags lists the most popular recent tags in decreasing popularity.
+//
+// Zendesk Core API docs: https://developer.zendesk.com/api-reference/ticketing/ticket-management/tags/#list-tags
+func (c *client) ListTags(opts *ListOptions) ([]Tag, error) {
+	return c.ListTagsContext(context.Background(), opts)
+}
+
+// ListTagsContext is like ListTags but uses ctx for the underlying request.
+func (c *client) ListTagsContext(ctx context.Context, opts *ListOptions) ([]Tag, error) {
+	params, err := listParams(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	out := new(tagsResponse)
+	err = c.get(ctx, "ListTags", routef("/api/v2/tags.json?%s", params.Encode()), out)
+	return out.Tags, err
+}
+
+// AutocompleteTags returns the tags that start with name.
+//
+// Zendesk Core API docs: https://developer.zendesk.com/api-reference/ticketing/ticket-management/tags/#search-tags
+func (c *client) AutocompleteTags(name string) ([]string, error) {
+	return c.AutocompleteTagsContext(context.Background(), name)
+}
+
+// AutocompleteTagsContext is like AutocompleteTags but uses ctx for the underlying request.
+func (c *client) AutocompleteTagsContext(ctx context.Context, name string) ([]string, error) {
+	out := new(APIPayload)
+	err := c.get(ctx, "AutocompleteTags", routef("/api/v2/autocomplete/tags.json?name=%s", url.QueryEscape(name)), out)
+	return out.Tags, err
+}
+
+// ListTicketTags lists the tags of a ticket.
+//
+// Zendesk Core API docs: https://developer.zendesk.com/api-reference/ticketing/ticket-management/tags/#list-resource-tags
+func (c *client) ListTicketTags(id int64) ([]string, error) {
+	return c.ListTicketTagsContext(context.Background(), id)
+}
+
+// ListTicketTagsContext is like ListTicketTags but uses ctx for the underlying request.
+func (c *client) ListTicketTagsContext(ctx context.Context, id int64) ([]string, error) {
+	out := new(APIPayload)
+	err := c.get(ctx, "ListTicketTags", routef("/api/v2/tickets/%d/tags.json", id), out)
+	return out.Tags, err
+}
+
+// SetTicketTags replaces the tags of a ticket and returns its tags. An empty
+// list of tags clears them.
+//
+// Zendesk Core API docs: https://developer.zendesk.com/api-reference/ticketing/ticket-management/tags/#set-tags
+func (c *client) SetTicketTags(id int64, tags []string) ([]string, error) {
+	return c.SetTicketTagsContext(context.Background(), id, tags)
+}
+
+// SetTicketTagsContext is like SetTicketTags but uses ctx for the underlying request.
+func (c *client) SetTicketTagsContext(ctx context.Context, id int64, tags []string) ([]string, error) {
+	in := newTagSet(tags)
+	out := new(APIPayload)
+	err := c.post(ctx, "SetTicketTags", routef("/api/v2/tickets/%d/tags.json", id), in, out)
+	return out.Tags, err
+}
+
+// AddTicketTags adds tags to a ticket and returns its tags.
+//
+// Zendesk Core API docs: https://developer.zendesk.com/api-reference/ticketing/ticket-management/tags/#add-tags
+func (c *client) AddTicketTags(id int64, tags []string) ([]string, error) {
+	return c.AddTicketTagsContext(context.Background(), id, tags)
+}
+
+// AddTicketTagsContext is like AddTicketTags but uses ctx for the underlying request.
+func (c *client) AddTicketTagsContext(ctx context.Context, id int64, tags []string) ([]string, error) {
+	in := &APIPayload{Tags: tags}
+	out := new(APIPayload)
+	err := c.put(ctx, "AddTicketTags", routef("/api/v2/tickets/%d/tags.json", id), in, out)
+	return out.Tags, err
+}
+
+// RemoveTicketTags removes tags from a ticket and returns its tags.
+//
+// Zendesk Core API docs: https://developer.zendesk.com/api-reference/ticketing/ticket-management/tags/#remove-tags
+func (c *client) RemoveTicketTags(id int64, tags []string) ([]string, error) {
+	return c.RemoveTicketTagsContext(context.Background(), id, tags)
+}
+
+// RemoveTicketTagsContext is like RemoveTicketTags but uses ctx for the underlying request.
+func (c *client) RemoveTicketTagsContext(ctx context.Context, id int64, tags []string) ([]string, error) {
+	in := &APIPayload{Tags: tags}
+	out := new(APIPayload)
+	err := c.deleteWithBody(ctx, "RemoveTicketTags", routef("/api/v2/tickets/%d/tags.json", id), in, out)
+	return out.Tags, err
+}
+
+// ListOrganizationTags lists the tags of an organization.
+//
+// Zendesk Core API docs: https://developer.zendesk.com/api-reference/ticketing/ticket-management/tags/#list-resource-tags
+func (c *client) ListOrganizationTags(id int64) ([]string, error) {
+	return c.ListOrganizationTagsContext(context.Background(), id)
+}
+
+// ListOrganizationTagsContext is like ListOrganizationTags but uses ctx for the underlying request.
+func (c *client) ListOrganizationTagsContext(ctx context.Context, id int64) ([]string, error) {
+	out := new(APIPayload)
+	err := c.get(ctx, "ListOrganizationTags", routef("/api/v2/organizations/%d/tags.json", id), out)
+	return out.Tags, err
+}
+
+// SetOrganizationTags replaces the tags of an organization and returns its
+// tags. An empty list of tags clears them.
+//
+// Zendesk Core API docs: https://developer.zendesk.com/api-reference/ticketing/ticket-management/tags/#set-tags
+func (c *client) SetOrganizationTags(id int64, tags []string) ([]string, error) {
+	return c.SetOrganizationTagsContext(context.Background(), id, tags)
+}
+
+// SetOrganizationTagsContext is like SetOrganizationTags but uses ctx for the underlying request.
+func (c *client) SetOrganizationTagsContext(ctx context.Context, id int64, tags []string) ([]string, error) {
+	in := newTagSet(tags)
+	out := new(APIPayload)
+	err := c.post(ctx, "SetOrganizationTags", routef("/api/v2/organizations/%d/tags.json", id), in, out)
+	return out.Tags, err
+}
+
+// AddOrganizationTags adds tags to an organization and returns its tags.
+//
+// Zendesk Core API docs: https://developer.zendesk.com/api-reference/ticketing/ticket-management/tags/#add-tags
+func (c *client) AddOrganizationTags(id int64, tags []string) ([]string, error) {
+	return c.AddOrganizationTagsContext(context.Background(), id, tags)
+}
+
+// AddOrganizationTagsContext is like AddOrganizationTags but uses ctx for the underlying request.
+func (c *client) AddOrganizationTagsContext(ctx context.Context, id int64, tags []string) ([]string, error) {
+	in := &APIPayload{Tags: tags}
+	out := new(APIPayload)
+	err := c.put(ctx, "AddOrganizationTags", routef("/api/v2/organizations/%d/tags.json", id), in, out)
+	return out.Tags, err
+}
+
+// RemoveOrganizationTags removes tags from an organization and returns its tags.
+//
+// Zendesk Core API docs: https://developer.zendesk.com/api-reference/ticketing/ticket-management/tags/#remove-tags
+func (c *client) RemoveOrganizationTags(id int64, tags []string) ([]string, error) {
+	return c.RemoveOrganizationTagsContext(context.Background(), id, tags)
+}
+
+// RemoveOrganizationTagsContext is like RemoveOrganizationTags but uses ctx for the underlying request.
+func (c *client) RemoveOrganizationTagsContext(ctx context.Context, id int64, tags []string) ([]string, error) {
+	in := &APIPayload{Tags: tags}
+	out := new(APIPayload)
+	err := c.deleteWithBody(ctx, "RemoveOrganizationTags", routef("/api/v2/organizations/%d/tags.json", id), in, out)
+	return out.Tags, err
+}
+
+// ListUserTags lists the tags of a user.
+//
+// Zendesk Core API docs: https://developer.zendesk.com/api-reference/ticketing/ticket-management/tags/#list-resource-tags
+func (c *client) ListUserTags(id int64) ([]string, error) {
+	return c.ListUserTagsContext(context.Background(), id)
+}
+
+// ListUserTagsContext is like ListUserTags but uses ctx for the underlying request.
+func (c *client) ListUserTagsContext(ctx context.Context, id int64) ([]string, error) {
+	out := new(APIPayload)
+	err := c.get(ctx, "ListUserTags", routef("/api/v2/users/%d/tags.json", id), out)
+	return out.Tags, err
+}
+
+// SetUserTags replaces the tags of a user and returns its tags. An empty list
+// of tags clears them.
+//
+// Zendesk Core API docs: https://developer.zendesk.com/api-reference/ticketing/ticket-management/tags/#set-tags
+func (c *client) SetUserTags(id int64, tags []string) ([]string, error) {
+	return c.SetUserTagsContext(context.Background(), id, tags)
+}
+
+// SetUserTagsContext is like SetUserTags but uses ctx for the underlying request.
+func (c *client) SetUserTagsContext(ctx context.Context, id int64, tags []string) ([]string, error) {
+	in := newTagSet(tags)
+	out := new(APIPayload)
+	err := c.post(ctx, "SetUserTags", routef("/api/v2/users/%d/tags.json", id), in, out)
+	return out.Tags, err
+}
+
+// RemoveUserTags removes tags from a user and returns its tags.
+//
+// Zendesk Core API docs: https://developer.zendesk.com/api-reference/ticketing/ticket-management/tags/#remove-tags
+func (c *client) RemoveUserTags(id int64, tags []string) ([]string, error) {
+	return c.RemoveUserTagsContext(context.Background(), id, tags)
+}
+
+// RemoveUserTagsContext is like RemoveUserTags but uses ctx for the underlying request.
+func (c *client) RemoveUserTagsContext(ctx context.Context, id int64, tags []string) ([]string, error) {
+	in := &APIPayload{Tags: tags}
+	out := new(APIPayload)
+	err := c.deleteWithBody(ctx, "RemoveUserTags", routef("/api/v2/users/%d/tags.json", id), in, out)
+	return out.Tags, err
+}
@@ -0,0 +1,97 @@
+package zendesk_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/MEDIGO/go-zendesk/zendesk"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTicketTags(t *testing.T) {
+	type call struct {
+		Method, URI string
+		Tags        []string
+	}
+	var calls []call
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var in struct {
+			Tags []string `json:"tags"`
+		}
+		data, _ := io.ReadAll(r.Body)
+		json.Unmarshal(data, &in)
+		calls = append(calls, call{r.Method, r.URL.RequestURI(), in.Tags})
+
+		fmt.Fprint(w, `{"tags":["a","b"]}`)
+	}))
+	defer server.Close()
+
+	client, err := zendesk.NewURLClient(server.URL, "", "")
+	require.NoError(t, err)
+
+	tags, err := client.ListTicketTags(1)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b"}, tags)
+
+	_, err = client.SetTicketTags(1, []string{"a", "b"})
+	require.NoError(t, err)
+	_, err = client.SetTicketTags(1, []string{})
+	require.NoError(t, err)
+	_, err = client.SetOrganizationTags(2, nil)
+	require.NoError(t, err)
+	_, err = client.AddTicketTags(1, []string{"b"})
+	require.NoError(t, err)
+	_, err = client.RemoveTicketTags(1, []string{"c"})
+	require.NoError(t, err)
+	_, err = client.RemoveOrganizationTags(2, []string{"c"})
+	require.NoError(t, err)
+	_, err = client.SetUserTags(3, []string{"d"})
+	require.NoError(t, err)
+
+	require.Equal(t, []call{
+		{"GET", "/api/v2/tickets/1/tags.json", nil},
+		{"POST", "/api/v2/tickets/1/tags.json", []string{"a", "b"}},
+		{"POST", "/api/v2/tickets/1/tags.json", []string{}},
+		{"POST", "/api/v2/organizations/2/tags.json", []string{}},
+		{"PUT", "/api/v2/tickets/1/tags.json", []string{"b"}},
+		{"DELETE", "/api/v2/tickets/1/tags.json", []string{"c"}},
+		{"DELETE", "/api/v2/organizations/2/tags.json", []string{"c"}},
+		{"POST", "/api/v2/users/3/tags.json", []string{"d"}},
+	}, calls)
+}
+
+func TestListTagsAndAutocomplete(t *testing.T) {
+	var requested []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = append(requested, r.URL.RequestURI())
+		if r.URL.Path == "/api/v2/tags.json" {
+			fmt.Fprint(w, `{"tags":[{"name":"urgent","count":12},{"name":"vip","count":3}],"count":2}`)
+			return
+		}
+		fmt.Fprint(w, `{"tags":["urgent","urgent_billing"]}`)
+	}))
+	defer server.Close()
+
+	client, err := zendesk.NewURLClient(server.URL, "", "")
+	require.NoError(t, err)
+
+	tags, err := client.ListTags(&zendesk.ListOptions{PerPage: 50})
+	require.NoError(t, err)
+	require.Len(t, tags, 2)
+	require.Equal(t, "urgent", *tags[0].Name)
+	require.Equal(t, int64(12), *tags[0].Count)
+
+	names, err := client.AutocompleteTags("urg ent")
+	require.NoError(t, err)
+	require.Equal(t, []string{"urgent", "urgent_billing"}, names)
+
+	require.Equal(t, []string{
+		"/api/v2/tags.json?per_page=50",
+		"/api/v2/autocomplete/tags.json?name=urg+ent",
+	}, requested)
+}
@@ -1,10 +1,7 @@
 package zendesk
 
 import (
-	"fmt"
-	"github.com/google/go-querystring/query"
-	"strconv"
-	"strings"
+	"context"
 	"time"
 )
 
@@ -68,61 +65,172 @@ type Requester struct {
 }
 
 func (c *client) ShowTicket(id int64) (*Ticket, error) {
+	return c.ShowTicketContext(context.Background(), id)
+}
+
+// ShowTicketContext is like ShowTicket but uses ctx for the underlying request.
+func (c *client) ShowTicketContext(ctx context.Context, id int64) (*Ticket, error) {
 	out := new(APIPayload)
-	err := c.get(fmt.Sprintf("/api/v2/tickets/%d.json", id), out)
+	err := c.get(ctx, "ShowTicket", routef("/api/v2/tickets/%d.json", id), out)
 	return out.Ticket, err
 }
 
 func (c *client) CreateTicket(ticket *Ticket) (*Ticket, error) {
+	return c.CreateTicketContext(context.Background(), ticket)
+}
+
+// CreateTicketContext is like CreateTicket but uses ctx for the underlying request.
+func (c *client) CreateTicketContext(ctx context.Context, ticket *Ticket) (*Ticket, error) {
 	in := &APIPayload{Ticket: ticket}
 	out := new(APIPayload)
-	err := c.post("/api/v2/tickets.json", in, out)
+	err := c.post(ctx, "CreateTicket", routef("/api/v2/tickets.json"), in, out)
 	return out.Ticket, err
 }
 
 func (c *client) UpdateTicket(id int64, ticket *Ticket) (*Ticket, error) {
+	return c.UpdateTicketContext(context.Background(), id, ticket)
+}
+
+// UpdateTicketContext is like UpdateTicket but uses ctx for the underlying request.
+func (c *client) UpdateTicketContext(ctx context.Context, id int64, ticket *Ticket) (*Ticket, error) {
 	in := &APIPayload{Ticket: ticket}
 	out := new(APIPayload)
-	err := c.put(fmt.Sprintf("/api/v2/tickets/%d.json", id), in, out)
+	err := c.put(ctx, "UpdateTicket", routef("/api/v2/tickets/%d.json", id), in, out)
 	return out.Ticket, err
 }
 
+// TicketMergeRequest describes the merge of source tickets into a target
+// ticket. The comments are added to the target and the source tickets, and
+// their public flags set whether they are visible to the requesters. The flags
+// left nil fall back to the defaults of Zendesk.
+//
+// Zendesk Core API docs: https://developer.zendesk.com/api-reference/ticketing/tickets/tickets/#merge-tickets-into-target-ticket
+type TicketMergeRequest struct {
+	SourceIDs             []int64 `json:"ids"`
+	TargetComment         *string `json:"target_comment,omitempty"`
+	SourceComment         *string `json:"source_comment,omitempty"`
+	TargetCommentIsPublic *bool   `json:"target_comment_is_public,omitempty"`
+	SourceCommentIsPublic *bool   `json:"source_comment_is_public,omitempty"`
+}
+
+// MergeTickets merges the source tickets of req into the target ticket in a
+// background job.
+//
+// Zendesk Core API docs: https://developer.zendesk.com/api-reference/ticketing/tickets/tickets/#merge-tickets-into-target-ticket
+func (c *client) MergeTickets(targetID int64, req *TicketMergeRequest) (*JobStatus, error) {
+	return c.MergeTicketsContext(context.Background(), targetID, req)
+}
+
+// MergeTicketsContext is like MergeTickets but uses ctx for the underlying request.
+func (c *client) MergeTicketsContext(ctx context.Context, targetID int64, req *TicketMergeRequest) (*JobStatus, error) {
+	out := new(APIPayload)
+	err := c.post(ctx, "MergeTickets", routef("/api/v2/tickets/%d/merge.json", targetID), req, out)
+	return out.JobStatus, err
+}
+
+// BatchUpdateManyTickets updates many tickets, identified by their id. Use
+// UpdateManyTickets to track the background job of the update.
+//
+// Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/support/tickets#update-many-tickets
 func (c *client) BatchUpdateManyTickets(tickets []Ticket) error {
+	return c.BatchUpdateManyTicketsContext(context.Background(), tickets)
+}
+
+// BatchUpdateManyTicketsContext is like BatchUpdateManyTickets but uses ctx for the underlying request.
+func (c *client) BatchUpdateManyTicketsContext(ctx context.Context, tickets []Ticket) error {
 	in := &APIPayload{Tickets: tickets}
 	out := new(APIPayload)
-	err := c.put("/api/v2/tickets/update_many.json", in, out)
+	err := c.put(ctx, "BatchUpdateManyTickets", routef("/api/v2/tickets/update_many.json"), in, out)
 	return err
 }
 
-func (c *client) BulkUpdateManyTickets(ids []int64, ticket *Ticket) error {
-	var parsed []string
-	for _, id := range ids {
-		parsed = append(parsed, strconv.FormatInt(id, 10))
-	}
+// BulkUpdateManyTickets applies the same update to many tickets in
+// background jobs, one for each chunk of 100 ids.
+//
+// Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/support/tickets#bulk-update-many-tickets
+func (c *client) BulkUpdateManyTickets(ids []int64, ticket *Ticket) ([]JobStatus, error) {
+	return c.BulkUpdateManyTicketsContext(context.Background(), ids, ticket)
+}
 
-	in := &APIPayload{Ticket: ticket}
-	out := new(APIPayload)
-	err := c.put(fmt.Sprintf("/api/v2/tickets/update_many.json?ids=%s", strings.Join(parsed, ",")), in, out)
-	return err
+// BulkUpdateManyTicketsContext is like BulkUpdateManyTickets but uses ctx for the underlying request.
+func (c *client) BulkUpdateManyTicketsContext(ctx context.Context, ids []int64, ticket *Ticket) ([]JobStatus, error) {
+	return chunked(ctx, c, ids, func(ctx context.Context, ids []int64) ([]JobStatus, error) {
+		in := &APIPayload{Ticket: ticket}
+		out := new(APIPayload)
+		err := c.put(ctx, "BulkUpdateManyTickets", routef("/api/v2/tickets/update_many.json?ids=%s", joinIDs(ids)), in, out)
+		return jobStatuses(out, err)
+	})
+}
+
+// CreateManyTickets creates tickets in background jobs, one for each chunk of
+// 100 tickets.
+//
+// Zendesk Core API docs: https://developer.zendesk.com/api-reference/ticketing/tickets/tickets/#create-many-tickets
+func (c *client) CreateManyTickets(tickets []Ticket) ([]JobStatus, error) {
+	return c.CreateManyTicketsContext(context.Background(), tickets)
+}
+
+// CreateManyTicketsContext is like CreateManyTickets but uses ctx for the underlying request.
+func (c *client) CreateManyTicketsContext(ctx context.Context, tickets []Ticket) ([]JobStatus, error) {
+	return chunked(ctx, c, tickets, func(ctx context.Context, tickets []Ticket) ([]JobStatus, error) {
+		in := &APIPayload{Tickets: tickets}
+		out := new(APIPayload)
+		err := c.post(ctx, "CreateManyTickets", routef("/api/v2/tickets/create_many.json"), in, out)
+		return jobStatuses(out, err)
+	})
+}
+
+// UpdateManyTickets updates tickets, identified by their id, in background
+// jobs, one for each chunk of 100 tickets.
+//
+// Zendesk Core API docs: https://developer.zendesk.com/api-reference/ticketing/tickets/tickets/#update-many-tickets
+func (c *client) UpdateManyTickets(tickets []Ticket) ([]JobStatus, error) {
+	return c.UpdateManyTicketsContext(context.Background(), tickets)
+}
+
+// UpdateManyTicketsContext is like UpdateManyTickets but uses ctx for the underlying request.
+func (c *client) UpdateManyTicketsContext(ctx context.Context, tickets []Ticket) ([]JobStatus, error) {
+	return chunked(ctx, c, tickets, func(ctx context.Context, tickets []Ticket) ([]JobStatus, error) {
+		in := &APIPayload{Tickets: tickets}
+		out := new(APIPayload)
+		err := c.put(ctx, "UpdateManyTickets", routef("/api/v2/tickets/update_many.json"), in, out)
+		return jobStatuses(out, err)
+	})
+}
+
+// DestroyManyTickets deletes tickets in background jobs, one for each chunk of
+// 100 tickets.
+//
+// Zendesk Core API docs: https://developer.zendesk.com/api-reference/ticketing/tickets/tickets/#bulk-delete-tickets
+func (c *client) DestroyManyTickets(ids []int64) ([]JobStatus, error) {
+	return c.DestroyManyTicketsContext(context.Background(), ids)
+}
+
+// DestroyManyTicketsContext is like DestroyManyTickets but uses ctx for the underlying request.
+func (c *client) DestroyManyTicketsContext(ctx context.Context, ids []int64) ([]JobStatus, error) {
+	return chunked(ctx, c, ids, func(ctx context.Context, ids []int64) ([]JobStatus, error) {
+		out := new(APIPayload)
+		err := c.delete(ctx, "DestroyManyTickets", routef("/api/v2/tickets/destroy_many.json?ids=%s", joinIDs(ids)), out)
+		return jobStatuses(out, err)
+	})
 }
 
 // ListOrganizationTickets list tickets for an organization
 //
 // Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/core/tickets#list-tickets
 func (c *client) ListOrganizationTickets(organizationID int64, options *ListOptions, sideloads ...SideLoad) (*ListResponse, error) {
-	params, err := query.Values(options)
+	return c.ListOrganizationTicketsContext(context.Background(), organizationID, options, sideloads...)
+}
+
+// ListOrganizationTicketsContext is like ListOrganizationTickets but uses ctx for the underlying request.
+func (c *client) ListOrganizationTicketsContext(ctx context.Context, organizationID int64, options *ListOptions, sideloads ...SideLoad) (*ListResponse, error) {
+	params, err := listParams(options, sideloads...)
 	if err != nil {
 		return nil, err
 	}
-	sideLoads := &SideLoadOptions{}
-	for _, opt := range sideloads {
-		opt(sideLoads)
-	}
-	if len(sideLoads.Include) > 0 {
-		params.Set("include", strings.Join(sideLoads.Include, ","))
-	}
+
 	out := new(APIPayload)
-	err = c.get(fmt.Sprintf("/api/v2/organizations/%d/tickets.json?%s", organizationID, params.Encode()), out)
+	err = c.get(ctx, "ListOrganizationTickets", routef("/api/v2/organizations/%d/tickets.json?%s", organizationID, params.Encode()), out)
 	if err != nil {
 		return nil, err
 	}
@@ -136,25 +244,30 @@ func (c *client) ListOrganizationTickets(organizationID int64, options *ListOpti
 	}, err
 }
 
+// ListOrganizationTicketsPager is like ListOrganizationTickets but returns a Pager over all the pages.
+func (c *client) ListOrganizationTicketsPager(organizationID int64, options *ListOptions, sideloads ...SideLoad) *Pager[Ticket] {
+	params, err := listParams(options, sideloads...)
+	rt := routef("/api/v2/organizations/%d/tickets.json?%s", organizationID, params.Encode())
+	return newURLPager(c, "ListOrganizationTicketsPager", rt, err, func(out *APIPayload) []Ticket { return out.Tickets })
+}
+
 // ListExternalIDTickets list tickets by external ID
 //
 // Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/support/tickets#list-tickets-by-external-id
 func (c *client) ListExternalIDTickets(externalID string, options *ListOptions, sideloads ...SideLoad) (*ListResponse, error) {
-	params, err := query.Values(options)
+	return c.ListExternalIDTicketsContext(context.Background(), externalID, options, sideloads...)
+}
+
+// ListExternalIDTicketsContext is like ListExternalIDTickets but uses ctx for the underlying request.
+func (c *client) ListExternalIDTicketsContext(ctx context.Context, externalID string, options *ListOptions, sideloads ...SideLoad) (*ListResponse, error) {
+	params, err := listParams(options, sideloads...)
 	if err != nil {
 		return nil, err
 	}
 	params.Set("external_id", externalID)
 
-	sideLoads := &SideLoadOptions{}
-	for _, opt := range sideloads {
-		opt(sideLoads)
-	}
-	if len(sideLoads.Include) > 0 {
-		params.Set("include", strings.Join(sideLoads.Include, ","))
-	}
 	out := new(APIPayload)
-	err = c.get(fmt.Sprintf("/api/v2/tickets.json?%s", params.Encode()), out)
+	err = c.get(ctx, "ListExternalIDTickets", routef("/api/v2/tickets.json?%s", params.Encode()), out)
 	if err != nil {
 		return nil, err
 	}
@@ -168,13 +281,28 @@ func (c *client) ListExternalIDTickets(externalID string, options *ListOptions,
 	}, err
 }
 
+// ListExternalIDTicketsPager is like ListExternalIDTickets but returns a Pager over all the pages.
+func (c *client) ListExternalIDTicketsPager(externalID string, options *ListOptions, sideloads ...SideLoad) *Pager[Ticket] {
+	params, err := listParams(options, sideloads...)
+	if err == nil {
+		params.Set("external_id", externalID)
+	}
+	rt := routef("/api/v2/tickets.json?%s", params.Encode())
+	return newURLPager(c, "ListExternalIDTicketsPager", rt, err, func(out *APIPayload) []Ticket { return out.Tickets })
+}
+
 // ListRequestedTickets lists tickets that the requesting agent recently viewed in the agent interface,
 // not recently created or updated tickets (unless by the agent recently in the agent interface).
 //
 // Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/core/tickets#list-tickets
 func (c *client) ListRequestedTickets(userID int64) ([]Ticket, error) {
+	return c.ListRequestedTicketsContext(context.Background(), userID)
+}
+
+// ListRequestedTicketsContext is like ListRequestedTickets but uses ctx for the underlying request.
+func (c *client) ListRequestedTicketsContext(ctx context.Context, userID int64) ([]Ticket, error) {
 	out := new(APIPayload)
-	err := c.get(fmt.Sprintf("/api/v2/users/%d/tickets/requested.json", userID), out)
+	err := c.get(ctx, "ListRequestedTickets", routef("/api/v2/users/%d/tickets/requested.json", userID), out)
 	return out.Tickets, err
 }
 
@@ -182,8 +310,13 @@ func (c *client) ListRequestedTickets(userID int64) ([]Ticket, error) {
 //
 // Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/support/tickets#list-collaborators-for-a-ticket
 func (c *client) ListTicketCollaborators(ticketID int64) ([]User, error) {
+	return c.ListTicketCollaboratorsContext(context.Background(), ticketID)
+}
+
+// ListTicketCollaboratorsContext is like ListTicketCollaborators but uses ctx for the underlying request.
+func (c *client) ListTicketCollaboratorsContext(ctx context.Context, ticketID int64) ([]User, error) {
 	out := new(APIPayload)
-	err := c.get(fmt.Sprintf("/api/v2/tickets/%d/collaborators.json", ticketID), out)
+	err := c.get(ctx, "ListTicketCollaborators", routef("/api/v2/tickets/%d/collaborators.json", ticketID), out)
 	return out.Users, err
 }
 
@@ -191,8 +324,13 @@ func (c *client) ListTicketCollaborators(ticketID int64) ([]User, error) {
 //
 // Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/support/tickets#list-followers-for-a-ticket
 func (c *client) ListTicketFollowers(ticketID int64) ([]User, error) {
+	return c.ListTicketFollowersContext(context.Background(), ticketID)
+}
+
+// ListTicketFollowersContext is like ListTicketFollowers but uses ctx for the underlying request.
+func (c *client) ListTicketFollowersContext(ctx context.Context, ticketID int64) ([]User, error) {
 	out := new(APIPayload)
-	err := c.get(fmt.Sprintf("/api/v2/tickets/%d/followers.json", ticketID), out)
+	err := c.get(ctx, "ListTicketFollowers", routef("/api/v2/tickets/%d/followers.json", ticketID), out)
 	return out.Users, err
 }
 
@@ -200,8 +338,13 @@ func (c *client) ListTicketFollowers(ticketID int64) ([]User, error) {
 //
 // Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/support/tickets#list-email-ccs-for-a-ticket
 func (c *client) ListTicketEmailCCs(ticketID int64) ([]User, error) {
+	return c.ListTicketEmailCCsContext(context.Background(), ticketID)
+}
+
+// ListTicketEmailCCsContext is like ListTicketEmailCCs but uses ctx for the underlying request.
+func (c *client) ListTicketEmailCCsContext(ctx context.Context, ticketID int64) ([]User, error) {
 	out := new(APIPayload)
-	err := c.get(fmt.Sprintf("/api/v2/tickets/%d/email_ccs.json", ticketID), out)
+	err := c.get(ctx, "ListTicketEmailCCs", routef("/api/v2/tickets/%d/email_ccs.json", ticketID), out)
 	return out.Users, err
 }
 
@@ -209,8 +352,13 @@ func (c *client) ListTicketEmailCCs(ticketID int64) ([]User, error) {
 //
 // Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/core/tickets#listing-ticket-incidents
 func (c *client) ListTicketIncidents(problemID int64) ([]Ticket, error) {
+	return c.ListTicketIncidentsContext(context.Background(), problemID)
+}
+
+// ListTicketIncidentsContext is like ListTicketIncidents but uses ctx for the underlying request.
+func (c *client) ListTicketIncidentsContext(ctx context.Context, problemID int64) ([]Ticket, error) {
 	out := new(APIPayload)
-	err := c.get(fmt.Sprintf("/api/v2/tickets/%d/incidents.json", problemID), out)
+	err := c.get(ctx, "ListTicketIncidents", routef("/api/v2/tickets/%d/incidents.json", problemID), out)
 
 	return out.Tickets, err
 }
@@ -219,19 +367,18 @@ func (c *client) ListTicketIncidents(problemID int64) ([]Ticket, error) {
 //
 // Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/support/tickets#list-tickets
 func (c *client) ListTickets(options *ListOptions, sideloads ...SideLoad) (*ListResponse, error) {
-	params, err := query.Values(options)
+	return c.ListTicketsContext(context.Background(), options, sideloads...)
+}
+
+// ListTicketsContext is like ListTickets but uses ctx for the underlying request.
+func (c *client) ListTicketsContext(ctx context.Context, options *ListOptions, sideloads ...SideLoad) (*ListResponse, error) {
+	params, err := listParams(options, sideloads...)
 	if err != nil {
 		return nil, err
 	}
-	sideLoads := &SideLoadOptions{}
-	for _, opt := range sideloads {
-		opt(sideLoads)
-	}
-	if len(sideLoads.Include) > 0 {
-		params.Set("include", strings.Join(sideLoads.Include, ","))
-	}
+
 	out := new(APIPayload)
-	err = c.get(fmt.Sprintf("/api/v2/tickets.json?%s", params.Encode()), out)
+	err = c.get(ctx, "ListTickets", routef("/api/v2/tickets.json?%s", params.Encode()), out)
 	if err != nil {
 		return nil, err
 	}
@@ -245,11 +392,51 @@ func (c *client) ListTickets(options *ListOptions, sideloads ...SideLoad) (*List
 	}, err
 }
 
+// ListTicketsPager is like ListTickets but returns a Pager over all the pages.
+func (c *client) ListTicketsPager(options *ListOptions, sideloads ...SideLoad) *Pager[Ticket] {
+	params, err := listParams(options, sideloads...)
+	rt := routef("/api/v2/tickets.json?%s", params.Encode())
+	return newURLPager(c, "ListTicketsPager", rt, err, func(out *APIPayload) []Ticket { return out.Tickets })
+}
+
+// ListTicketsCursor lists all tickets using cursor pagination.
+//
+// Zendesk Core API docs: https://developer.zendesk.com/api-reference/introduction/pagination/#using-cursor-pagination
+func (c *client) ListTicketsCursor(options *CursorOptions, sideloads ...SideLoad) (*ListResponse, error) {
+	return c.ListTicketsCursorContext(context.Background(), options, sideloads...)
+}
+
+// ListTicketsCursorContext is like ListTicketsCursor but uses ctx for the underlying request.
+func (c *client) ListTicketsCursorContext(ctx context.Context, options *CursorOptions, sideloads ...SideLoad) (*ListResponse, error) {
+	params, err := listParams(options, sideloads...)
+	if err != nil {
+		return nil, err
+	}
+
+	out := new(APIPayload)
+	err = c.get(ctx, "ListTicketsCursor", routef("/api/v2/tickets.json?%s", params.Encode()), out)
+	if err != nil {
+		return nil, err
+	}
+	return &ListResponse{
+		Tickets: out.Tickets,
+		Users:   out.Users,
+		Groups:  out.Groups,
+		Meta:    out.Meta,
+		Links:   out.Links,
+	}, nil
+}
+
 // DeleteTickets deletes a Ticket.
 //
 // Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/core/tickets#delete-ticket
 func (c *client) DeleteTicket(id int64) error {
-	return c.delete(fmt.Sprintf("/api/v2/tickets/%d.json", id), nil)
+	return c.DeleteTicketContext(context.Background(), id)
+}
+
+// DeleteTicketContext is like DeleteTicket but uses ctx for the underlying request.
+func (c *client) DeleteTicketContext(ctx context.Context, id int64) error {
+	return c.delete(ctx, "DeleteTicket", routef("/api/v2/tickets/%d.json", id), nil)
 }
 
 // PermanentlyDeleteTicket purges a ticket with all it's associated data - recordings & attachments
@@ -257,7 +444,12 @@ func (c *client) DeleteTicket(id int64) error {
 //
 // Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/core/tickets#delete-tickets-permanently
 func (c *client) PermanentlyDeleteTicket(id int64) (*JobStatus, error) {
+	return c.PermanentlyDeleteTicketContext(context.Background(), id)
+}
+
+// PermanentlyDeleteTicketContext is like PermanentlyDeleteTicket but uses ctx for the underlying request.
+func (c *client) PermanentlyDeleteTicketContext(ctx context.Context, id int64) (*JobStatus, error) {
 	out := new(APIPayload)
-	err := c.delete(fmt.Sprintf("/api/v2/deleted_tickets/%d.json", id), out)
+	err := c.delete(ctx, "PermanentlyDeleteTicket", routef("/api/v2/deleted_tickets/%d.json", id), out)
 	return out.JobStatus, err
 }
@@ -1,11 +1,8 @@
 package zendesk
 
 import (
-	"fmt"
-	"strings"
+	"context"
 	"time"
-
-	"github.com/google/go-querystring/query"
 )
 
 // TicketComment represents a comment on a Ticket.
@@ -29,25 +26,29 @@ type RedactedString struct {
 }
 
 func (c *client) ListTicketComments(id int64) ([]TicketComment, error) {
+	return c.ListTicketCommentsContext(context.Background(), id)
+}
+
+// ListTicketCommentsContext is like ListTicketComments but uses ctx for the underlying request.
+func (c *client) ListTicketCommentsContext(ctx context.Context, id int64) ([]TicketComment, error) {
 	out := new(APIPayload)
-	err := c.get(fmt.Sprintf("/api/v2/tickets/%d/comments.json", id), out)
+	err := c.get(ctx, "ListTicketComments", routef("/api/v2/tickets/%d/comments.json", id), out)
 	return out.Comments, err
 }
 
 func (c *client) ListTicketCommentsFull(id int64, options *ListOptions, sideloads ...SideLoad) (*ListResponse, error) {
-	params, err := query.Values(options)
+	return c.ListTicketCommentsFullContext(context.Background(), id, options, sideloads...)
+}
+
+// ListTicketCommentsFullContext is like ListTicketCommentsFull but uses ctx for the underlying request.
+func (c *client) ListTicketCommentsFullContext(ctx context.Context, id int64, options *ListOptions, sideloads ...SideLoad) (*ListResponse, error) {
+	params, err := listParams(options, sideloads...)
 	if err != nil {
 		return nil, err
 	}
-	sideLoads := &SideLoadOptions{}
-	for _, opt := range sideloads {
-		opt(sideLoads)
-	}
-	if len(sideLoads.Include) > 0 {
-		params.Set("include", strings.Join(sideLoads.Include, ","))
-	}
+
 	out := new(APIPayload)
-	err = c.get(fmt.Sprintf("/api/v2/tickets/%d/comments.json?%s", id, params.Encode()), out)
+	err = c.get(ctx, "ListTicketCommentsFull", routef("/api/v2/tickets/%d/comments.json?%s", id, params.Encode()), out)
 
 	return &ListResponse{
 		Comments:     out.Comments,
@@ -59,14 +60,54 @@ func (c *client) ListTicketCommentsFull(id int64, options *ListOptions, sideload
 	}, err
 }
 
+// ListTicketCommentsPager is like ListTicketCommentsFull but returns a Pager over all the pages.
+func (c *client) ListTicketCommentsPager(id int64, options *ListOptions, sideloads ...SideLoad) *Pager[TicketComment] {
+	params, err := listParams(options, sideloads...)
+	rt := routef("/api/v2/tickets/%d/comments.json?%s", id, params.Encode())
+	return newURLPager(c, "ListTicketCommentsPager", rt, err, func(out *APIPayload) []TicketComment { return out.Comments })
+}
+
+// ListTicketCommentsCursor lists the comments of a ticket using cursor pagination.
+//
+// Zendesk Core API docs: https://developer.zendesk.com/api-reference/introduction/pagination/#using-cursor-pagination
+func (c *client) ListTicketCommentsCursor(id int64, options *CursorOptions, sideloads ...SideLoad) (*ListResponse, error) {
+	return c.ListTicketCommentsCursorContext(context.Background(), id, options, sideloads...)
+}
+
+// ListTicketCommentsCursorContext is like ListTicketCommentsCursor but uses ctx for the underlying request.
+func (c *client) ListTicketCommentsCursorContext(ctx context.Context, id int64, options *CursorOptions, sideloads ...SideLoad) (*ListResponse, error) {
+	params, err := listParams(options, sideloads...)
+	if err != nil {
+		return nil, err
+	}
+
+	out := new(APIPayload)
+	err = c.get(ctx, "ListTicketCommentsCursor", routef("/api/v2/tickets/%d/comments.json?%s", id, params.Encode()), out)
+	if err != nil {
+		return nil, err
+	}
+	return &ListResponse{
+		Comments: out.Comments,
+		Users:    out.Users,
+		Groups:   out.Groups,
+		Meta:     out.Meta,
+		Links:    out.Links,
+	}, nil
+}
+
 // Redact Comment String removes a string in the comment text
 //
 // Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/core/ticket_comments#redact-string-in-comment
 func (c *client) RedactCommentString(id, ticketID int64, text string) (*TicketComment, error) {
+	return c.RedactCommentStringContext(context.Background(), id, ticketID, text)
+}
+
+// RedactCommentStringContext is like RedactCommentString but uses ctx for the underlying request.
+func (c *client) RedactCommentStringContext(ctx context.Context, id, ticketID int64, text string) (*TicketComment, error) {
 	in := &RedactedString{Text: &text}
 	out := new(APIPayload)
-	err := c.put(
-		fmt.Sprintf("/api/v2/tickets/%d/comments/%d/redact", ticketID, id),
+	err := c.put(ctx, "RedactCommentString",
+		routef("/api/v2/tickets/%d/comments/%d/redact", ticketID, id),
 		in,
 		out)
 
@@ -0,0 +1,315 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// TicketEvent represents an update of a ticket, as returned by the incremental ticket event export.
+//
+// Zendesk Core API docs: https://developer.zendesk.com/api-reference/ticketing/ticket-management/incremental_exports/#incremental-ticket-event-export
+type TicketEvent struct {
+	ID             *int64             `json:"id,omitempty"`
+	TicketID       *int64             `json:"ticket_id,omitempty"`
+	Timestamp      *int64             `json:"timestamp,omitempty"`
+	CreatedAt      *time.Time         `json:"created_at,omitempty"`
+	UpdaterID      *int64             `json:"updater_id,omitempty"`
+	Via            *string            `json:"via,omitempty"`
+	System         *TicketEventSystem `json:"system,omitempty"`
+	EventType      *string            `json:"event_type,omitempty"`
+	MergedTicketID *int64             `json:"merged_ticket_id,omitempty"`
+	ChildEvents    TicketChildEvents  `json:"child_events,omitempty"`
+}
+
+// TicketEventSystem represents the system information of the client that updated a ticket.
+type TicketEventSystem struct {
+	Client    *string  `json:"client,omitempty"`
+	Location  *string  `json:"location,omitempty"`
+	Latitude  *float64 `json:"latitude,omitempty"`
+	Longitude *float64 `json:"longitude,omitempty"`
+	IPAddress *string  `json:"ip_address,omitempty"`
+}
+
+// TicketChildEvents holds the changes made by a ticket update, such as field
+// changes or, when the comment_events side-load is requested, comments. They're
+// decoded into the same structs as the events of a TicketAudit, e.g. *ChangeEvent
+// or *CommentEvent, and into a *RawAuditEvent for the other types.
+//
+// The child events name the changed field by its key, e.g. "status": "solved",
+// which is decoded into the FieldName and Value of the event, and their via is
+// decoded into the Channel of its Via.
+type TicketChildEvents []TicketAuditEvent
+
+// childEventKeys lists the keys of the child events that aren't ticket fields.
+var childEventKeys = map[string]bool{
+	"id": true, "event_type": true, "via": true, "via_reference_id": true,
+	"previous_value": true, "added_tags": true, "removed_tags": true,
+}
+
+// UnmarshalJSON decodes each event into the struct matching its type.
+func (events *TicketChildEvents) UnmarshalJSON(data []byte) error {
+	var raws []json.RawMessage
+	if err := json.Unmarshal(data, &raws); err != nil {
+		return err
+	}
+
+	if raws == nil {
+		*events = nil
+		return nil
+	}
+
+	decoded := make(TicketChildEvents, len(raws))
+	for i, raw := range raws {
+		event, err := unmarshalChildEvent(raw)
+		if err != nil {
+			return err
+		}
+		decoded[i] = event
+	}
+
+	*events = decoded
+	return nil
+}
+
+// MarshalJSON encodes the events in the shape of the child events.
+func (events TicketChildEvents) MarshalJSON() ([]byte, error) {
+	if events == nil {
+		return []byte("null"), nil
+	}
+
+	raws := make([]json.RawMessage, len(events))
+	for i, event := range events {
+		if event == nil {
+			raws[i] = json.RawMessage("null")
+			continue
+		}
+
+		raw, err := marshalChildEvent(event)
+		if err != nil {
+			return nil, err
+		}
+		raws[i] = raw
+	}
+	return json.Marshal(raws)
+}
+
+// unmarshalChildEvent converts a child event to the shape of the audit events
+// before decoding it.
+func unmarshalChildEvent(raw json.RawMessage) (TicketAuditEvent, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return nil, err
+	}
+
+	var eventType string
+	if data, ok := fields["event_type"]; ok {
+		if err := json.Unmarshal(data, &eventType); err != nil {
+			return nil, err
+		}
+		fields["type"] = data
+		delete(fields, "event_type")
+	}
+
+	if via, ok := fields["via"]; ok {
+		var channel *string
+		if err := json.Unmarshal(via, &channel); err != nil {
+			return nil, err
+		}
+		delete(fields, "via")
+		if channel != nil {
+			fields["via"], _ = json.Marshal(Via{Channel: channel})
+		}
+	}
+
+	if eventType == "Create" || eventType == "Change" {
+		var name string
+		for key := range fields {
+			if key != "type" && !childEventKeys[key] {
+				if name != "" {
+					// Only a single field is expected, leave the others undecoded.
+					name = ""
+					break
+				}
+				name = key
+			}
+		}
+		if name != "" {
+			fields["field_name"], _ = json.Marshal(name)
+			fields["value"] = fields[name]
+			delete(fields, name)
+		}
+	}
+
+	normalized, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	event, err := unmarshalAuditEvent(normalized)
+	if err != nil {
+		return nil, err
+	}
+
+	if rawEvent, ok := event.(*RawAuditEvent); ok {
+		rawEvent.Raw = append(json.RawMessage(nil), raw...)
+	}
+	return event, nil
+}
+
+// marshalChildEvent encodes event in the shape of the child events.
+func marshalChildEvent(event TicketAuditEvent) (json.RawMessage, error) {
+	data, err := marshalAuditEvent(event)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := event.(*RawAuditEvent); ok {
+		return data, nil
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+
+	fields["event_type"] = fields["type"]
+	delete(fields, "type")
+
+	if via, ok := fields["via"]; ok {
+		var v Via
+		if err := json.Unmarshal(via, &v); err != nil {
+			return nil, err
+		}
+		delete(fields, "via")
+		if v.Channel != nil {
+			fields["via"], _ = json.Marshal(*v.Channel)
+		}
+	}
+
+	if nameData, ok := fields["field_name"]; ok {
+		var name string
+		if err := json.Unmarshal(nameData, &name); err != nil {
+			return nil, err
+		}
+		fields[name] = fields["value"]
+		if fields[name] == nil {
+			fields[name] = json.RawMessage("null")
+		}
+		delete(fields, "field_name")
+		delete(fields, "value")
+	}
+
+	return json.Marshal(fields)
+}
+
+// TicketMetricEvent represents a change of a ticket metric, such as the reply time.
+//
+// Zendesk Core API docs: https://developer.zendesk.com/api-reference/ticketing/tickets/ticket_metric_events/
+type TicketMetricEvent struct {
+	ID         *int64     `json:"id,omitempty"`
+	TicketID   *int64     `json:"ticket_id,omitempty"`
+	Metric     *string    `json:"metric,omitempty"`
+	InstanceID *int64     `json:"instance_id,omitempty"`
+	Type       *string    `json:"type,omitempty"`
+	Time       *time.Time `json:"time,omitempty"`
+
+	// Set by apply_sla and apply_group_sla events.
+	SLA *TicketMetricSLA `json:"sla,omitempty"`
+	// Set by update_status events.
+	Status *TicketMetricStatus `json:"status,omitempty"`
+	// Set by breach events.
+	Deleted *bool `json:"deleted,omitempty"`
+}
+
+// TicketMetricSLA represents the SLA policy target applied to a ticket metric.
+type TicketMetricSLA struct {
+	Target        *int64                 `json:"target,omitempty"`
+	BusinessHours *bool                  `json:"business_hours,omitempty"`
+	Policy        *TicketMetricSLAPolicy `json:"policy,omitempty"`
+}
+
+// TicketMetricSLAPolicy represents the SLA policy applied to a ticket metric.
+type TicketMetricSLAPolicy struct {
+	ID          *int64  `json:"id,omitempty"`
+	Title       *string `json:"title,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// TicketMetricStatus represents the time elapsed on a ticket metric, in minutes.
+type TicketMetricStatus struct {
+	Calendar *int64 `json:"calendar,omitempty"`
+	Business *int64 `json:"business,omitempty"`
+}
+
+// IncrementalTicketEvents returns the ticket events that happened since the
+// given start time, using the time-based incremental export. Use the
+// IncludeCommentEvents side-load to get the comments of each update.
+//
+// Zendesk Core API docs: https://developer.zendesk.com/api-reference/ticketing/ticket-management/incremental_exports/#incremental-ticket-event-export
+func (c *client) IncrementalTicketEvents(options *IncrementalOptions, sideloads ...SideLoad) (*IncrementalExport, error) {
+	return c.IncrementalTicketEventsContext(context.Background(), options, sideloads...)
+}
+
+// IncrementalTicketEventsContext is like IncrementalTicketEvents but uses ctx for the underlying request.
+func (c *client) IncrementalTicketEventsContext(ctx context.Context, options *IncrementalOptions, sideloads ...SideLoad) (*IncrementalExport, error) {
+	return c.incrementalExport(ctx, "IncrementalTicketEvents", "/api/v2/incremental/ticket_events.json", options, sideloads...)
+}
+
+// IncrementalTicketEventsPager returns a Pager over the ticket events that
+// happened since the given start time, stopping at the end of the stream.
+func (c *client) IncrementalTicketEventsPager(options *IncrementalOptions, sideloads ...SideLoad) *Pager[TicketEvent] {
+	params, err := incrementalParams(options, sideloads...)
+	rt := routef("/api/v2/incremental/ticket_events.json?%s", params.Encode())
+	return newURLPager(c, "IncrementalTicketEventsPager", rt, err, func(out *APIPayload) []TicketEvent { return out.TicketEvents })
+}
+
+// IncrementalTicketMetricEvents returns the ticket metric events that happened
+// since the given start time.
+//
+// Zendesk Core API docs: https://developer.zendesk.com/api-reference/ticketing/tickets/ticket_metric_events/#list-ticket-metric-events
+func (c *client) IncrementalTicketMetricEvents(options *IncrementalOptions) (*IncrementalExport, error) {
+	return c.IncrementalTicketMetricEventsContext(context.Background(), options)
+}
+
+// IncrementalTicketMetricEventsContext is like IncrementalTicketMetricEvents but uses ctx for the underlying request.
+func (c *client) IncrementalTicketMetricEventsContext(ctx context.Context, options *IncrementalOptions) (*IncrementalExport, error) {
+	return c.incrementalExport(ctx, "IncrementalTicketMetricEvents", "/api/v2/incremental/ticket_metric_events.json", options)
+}
+
+// IncrementalTicketMetricEventsPager returns a Pager over the ticket metric
+// events that happened since the given start time. The export reports no
+// end_of_stream, so the pager stops at a page without events or whose end time
+// doesn't move past its start time.
+func (c *client) IncrementalTicketMetricEventsPager(options *IncrementalOptions) *Pager[TicketMetricEvent] {
+	params, err := incrementalParams(options)
+	rt := routef("/api/v2/incremental/ticket_metric_events.json?%s", params.Encode())
+
+	var start int64
+	if options != nil {
+		start = options.StartTime
+	}
+
+	return NewPager(func(ctx context.Context, page Page) ([]TicketMetricEvent, Page, error) {
+		if err != nil {
+			return nil, Page{}, err
+		}
+
+		if page.URL != "" {
+			rt = route{endpoint: page.URL, template: rt.template}
+		}
+
+		out := new(APIPayload)
+		if err := c.get(ctx, "IncrementalTicketMetricEventsPager", rt, out); err != nil {
+			return nil, Page{}, err
+		}
+
+		var next Page
+		if out.NextPage != nil && len(out.TicketMetricEvents) > 0 && out.EndTime != nil && *out.EndTime > start {
+			next.URL = *out.NextPage
+			start = *out.EndTime
+		}
+
+		return out.TicketMetricEvents, next, nil
+	})
+}
@@ -0,0 +1,145 @@
+package zendesk_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/MEDIGO/go-zendesk/zendesk"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIncrementalTicketEventsPager(t *testing.T) {
+	var requested []string
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = append(requested, r.URL.RequestURI())
+
+		if r.URL.Query().Get("start_time") == "100" {
+			fmt.Fprintf(w, `{"ticket_events":[{"id":1,"ticket_id":10,"event_type":"Audit","child_events":[
+				{"id":11,"event_type":"Change","via":"Web form","status":"solved","previous_value":"open"},
+				{"id":12,"event_type":"Comment","author_id":5,"body":"Done","public":true}
+			]}],"next_page":"%s/api/v2/incremental/ticket_events.json?include=comment_events&start_time=200","end_time":200,"end_of_stream":false}`, server.URL)
+			return
+		}
+		fmt.Fprintf(w, `{"ticket_events":[{"id":2,"ticket_id":10,"event_type":"Audit"}],"next_page":"%s/api/v2/incremental/ticket_events.json?include=comment_events&start_time=300","end_time":300,"end_of_stream":true}`, server.URL)
+	}))
+	defer server.Close()
+
+	client, err := zendesk.NewURLClient(server.URL, "", "")
+	require.NoError(t, err)
+
+	events, err := client.IncrementalTicketEventsPager(&zendesk.IncrementalOptions{StartTime: 100}, zendesk.IncludeCommentEvents()).All(context.Background(), 0)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	require.Len(t, requested, 2, "expected to stop at the end of the stream")
+	require.Equal(t, "/api/v2/incremental/ticket_events.json?include=comment_events&start_time=100", requested[0])
+
+	change, ok := events[0].ChildEvents[0].(*zendesk.ChangeEvent)
+	require.True(t, ok, "expected a *ChangeEvent, got %T", events[0].ChildEvents[0])
+	require.Equal(t, "status", *change.FieldName)
+	require.JSONEq(t, `"solved"`, string(change.Value))
+	require.JSONEq(t, `"open"`, string(change.PreviousValue))
+	require.Equal(t, "Web form", *change.Via.Channel)
+
+	comment, ok := events[0].ChildEvents[1].(*zendesk.CommentEvent)
+	require.True(t, ok, "expected a *CommentEvent, got %T", events[0].ChildEvents[1])
+	require.Equal(t, "Done", *comment.Body)
+	require.True(t, *comment.Public)
+}
+
+func TestTicketChildEventsRoundTrip(t *testing.T) {
+	in := `[
+		{"id":11,"event_type":"Change","via":"Web form","previous_value":["a"],"tags":["a","b"],"added_tags":["b"]},
+		{"id":12,"event_type":"Create","priority":null},
+		{"id":13,"event_type":"Cc","via":"Web form","recipients":[1]}
+	]`
+
+	var events zendesk.TicketChildEvents
+	require.NoError(t, json.Unmarshal([]byte(in), &events))
+	require.Len(t, events, 3)
+
+	change := events[0].(*zendesk.ChangeEvent)
+	require.Equal(t, "tags", *change.FieldName)
+	require.Equal(t, []string{"b"}, change.AddedTags)
+	require.Equal(t, "Cc", events[2].EventType())
+
+	out, err := json.Marshal(events)
+	require.NoError(t, err)
+	require.JSONEq(t, in, string(out))
+}
+
+// newMetricEventsServer serves a ticket metric events export whose pages from
+// the start time 100 on each hold one event and end 100 seconds later, up to the
+// start time 300. The page at 300 holds no event when empty is set, and an
+// event that doesn't move past its start time otherwise. Like Zendesk, every
+// page has a next_page.
+func newMetricEventsServer(t *testing.T, empty bool, requested *[]string) *httptest.Server {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requested = append(*requested, r.URL.RequestURI())
+		if len(*requested) > 10 {
+			t.Error("expected the export to stop")
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		var start int64
+		fmt.Sscan(r.URL.Query().Get("start_time"), &start)
+
+		events, end := fmt.Sprintf(`[{"id":%d,"ticket_id":10}]`, start), start+100
+		if start >= 300 {
+			end = start
+			if empty {
+				events = `[]`
+			}
+		}
+		fmt.Fprintf(w, `{"ticket_metric_events":%s,"next_page":"%s/api/v2/incremental/ticket_metric_events.json?start_time=%d","end_time":%d}`, events, server.URL, end, end)
+	}))
+	return server
+}
+
+func TestIncrementalTicketMetricEventsPager(t *testing.T) {
+	for _, empty := range []bool{true, false} {
+		var requested []string
+		server := newMetricEventsServer(t, empty, &requested)
+		defer server.Close()
+
+		client, err := zendesk.NewURLClient(server.URL, "", "")
+		require.NoError(t, err)
+
+		events, err := client.IncrementalTicketMetricEventsPager(&zendesk.IncrementalOptions{StartTime: 100}).All(context.Background(), 0)
+		require.NoError(t, err)
+		require.Len(t, requested, 3, "expected to stop at the page that doesn't move past its start time")
+		if empty {
+			require.Len(t, events, 2)
+		} else {
+			require.Len(t, events, 3)
+		}
+	}
+}
+
+func TestIncrementalTicketMetricEvents(t *testing.T) {
+	var requested string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = r.URL.RequestURI()
+		fmt.Fprint(w, `{"ticket_metric_events":[
+			{"id":1,"ticket_id":10,"metric":"reply_time","instance_id":1,"type":"apply_sla","time":"2020-01-01T00:00:00Z","sla":{"target":60,"business_hours":false,"policy":{"id":3,"title":"Gold"}}},
+			{"id":2,"ticket_id":10,"metric":"reply_time","instance_id":1,"type":"update_status","time":"2020-01-01T00:30:00Z","status":{"calendar":30,"business":20}}
+		],"next_page":null,"count":2,"end_time":1577839800}`)
+	}))
+	defer server.Close()
+
+	client, err := zendesk.NewURLClient(server.URL, "", "")
+	require.NoError(t, err)
+
+	page, err := client.IncrementalTicketMetricEvents(&zendesk.IncrementalOptions{StartTime: 1577836800})
+	require.NoError(t, err)
+	require.Equal(t, "/api/v2/incremental/ticket_metric_events.json?start_time=1577836800", requested)
+	require.Len(t, page.TicketMetricEvents, 2)
+	require.Equal(t, "Gold", *page.TicketMetricEvents[0].SLA.Policy.Title)
+	require.Equal(t, int64(30), *page.TicketMetricEvents[1].Status.Calendar)
+}
@@ -1,7 +1,7 @@
 package zendesk
 
 import (
-	"fmt"
+	"context"
 	"time"
 )
 
@@ -19,8 +19,13 @@ type TicketField struct {
 
 // ListTicketFields list all availbale custom ticket fields
 func (c *client) ListTicketFields() ([]TicketField, error) {
+	return c.ListTicketFieldsContext(context.Background())
+}
+
+// ListTicketFieldsContext is like ListTicketFields but uses ctx for the underlying request.
+func (c *client) ListTicketFieldsContext(ctx context.Context) ([]TicketField, error) {
 	out := new(APIPayload)
-	err := c.get(fmt.Sprintf("/api/v2/ticket_fields.json"), out)
+	err := c.get(ctx, "ListTicketFields", routef("/api/v2/ticket_fields.json"), out)
 
 	return out.TicketFields, err
 }
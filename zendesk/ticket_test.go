@@ -1,7 +1,14 @@
 package zendesk
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -154,13 +161,44 @@ func TestBulkUpdateManyTickets(t *testing.T) {
 	require.True(t, contains(one.Tags, "test"))
 	require.True(t, contains(two.Tags, "test"))
 
-	err = client.BulkUpdateManyTickets([]int64{*one.ID, *two.ID}, &Ticket{
+	_, err = client.BulkUpdateManyTickets([]int64{*one.ID, *two.ID}, &Ticket{
 		AdditionalTags: []string{"a_new_tag"},
 		RemoveTags:     []string{"test"},
 	})
 	require.NoError(t, err)
 }
 
+func TestMergeTickets(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode.")
+	}
+
+	client, err := NewEnvClient()
+	require.NoError(t, err)
+
+	user := randUser(t, client)
+	defer client.DeleteUser(*user.ID)
+
+	target := randTicket(t, client, user)
+	defer client.DeleteTicket(*target.ID)
+
+	source := randTicket(t, client, user)
+	defer client.DeleteTicket(*source.ID)
+
+	job, err := client.MergeTickets(*target.ID, &TicketMergeRequest{
+		SourceIDs:             []int64{*source.ID},
+		TargetComment:         String("Merged duplicate"),
+		SourceComment:         String("Closed as duplicate"),
+		TargetCommentIsPublic: Bool(false),
+		SourceCommentIsPublic: Bool(false),
+	})
+	require.NoError(t, err)
+	require.NotNil(t, job.ID)
+
+	_, err = client.WaitForJob(context.Background(), *job.ID, time.Second)
+	require.NoError(t, err)
+}
+
 func TestListTicketIncidents(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping integration test in short mode.")
@@ -208,3 +246,68 @@ func contains(l []string, s string) bool {
 	}
 	return false
 }
+
+func TestListTicketsCursorSideLoads(t *testing.T) {
+	var requested string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = r.URL.RequestURI()
+		fmt.Fprint(w, `{"tickets":[{"id":1,"requester_id":2}],"users":[{"id":2}],"meta":{"has_more":false}}`)
+	}))
+	defer server.Close()
+
+	client, err := NewURLClient(server.URL, "", "")
+	require.NoError(t, err)
+
+	res, err := client.ListTicketsCursor(&CursorOptions{PageSize: 10, Sort: "-updated_at"}, IncludeUsers())
+	require.NoError(t, err)
+	require.Len(t, res.Tickets, 1)
+	require.Len(t, res.Users, 1)
+	require.False(t, res.Meta.HasMore)
+	require.Nil(t, res.Links)
+	require.Equal(t, "/api/v2/tickets.json?include=users&page%5Bsize%5D=10&sort=-updated_at", requested)
+}
+
+func TestMergeTicketsPayload(t *testing.T) {
+	var uri string
+	var body map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		uri = r.URL.RequestURI()
+		data, _ := io.ReadAll(r.Body)
+		json.Unmarshal(data, &body)
+		fmt.Fprint(w, `{"job_status":{"id":"abc","status":"queued"}}`)
+	}))
+	defer server.Close()
+
+	client, err := NewURLClient(server.URL, "", "")
+	require.NoError(t, err)
+
+	job, err := client.MergeTickets(1, &TicketMergeRequest{
+		SourceIDs:             []int64{2, 3},
+		TargetComment:         String("Merged"),
+		SourceComment:         String("Duplicate"),
+		TargetCommentIsPublic: Bool(true),
+		SourceCommentIsPublic: Bool(false),
+	})
+	require.NoError(t, err)
+	require.Equal(t, "abc", *job.ID)
+	require.Equal(t, "/api/v2/tickets/1/merge.json", uri)
+	require.Equal(t, map[string]interface{}{
+		"ids":                      []interface{}{2.0, 3.0},
+		"target_comment":           "Merged",
+		"source_comment":           "Duplicate",
+		"target_comment_is_public": true,
+		"source_comment_is_public": false,
+	}, body)
+}
+
+func TestMockClientMergeTickets(t *testing.T) {
+	m := new(MockClient)
+	req := &TicketMergeRequest{SourceIDs: []int64{2}, TargetComment: String("Merged")}
+	m.On("MergeTickets", int64(1), req).Return(&JobStatus{ID: String("abc")}, nil)
+
+	var client Client = m
+	job, err := client.MergeTickets(1, req)
+	require.NoError(t, err)
+	require.Equal(t, "abc", *job.ID)
+	m.AssertExpectations(t)
+}
@@ -1,12 +1,10 @@
 package zendesk
 
 import (
-	"fmt"
+	"context"
 	"strconv"
 	"strings"
 	"time"
-
-	"github.com/google/go-querystring/query"
 )
 
 // User represents a Zendesk user.
@@ -62,40 +60,85 @@ type ComplianceDeletionStatus struct {
 //
 // Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/core/users#show-user
 func (c *client) ShowUser(id int64) (*User, error) {
+	return c.ShowUserContext(context.Background(), id)
+}
+
+// ShowUserContext is like ShowUser but uses ctx for the underlying request.
+func (c *client) ShowUserContext(ctx context.Context, id int64) (*User, error) {
 	out := new(APIPayload)
-	err := c.get(fmt.Sprintf("/api/v2/users/%d.json", id), out)
+	err := c.get(ctx, "ShowUser", routef("/api/v2/users/%d.json", id), out)
 	return out.User, err
 }
 
 // ShowManyUsers accepts a comma-separated list of user ids.
+// The ids are sent in chunks of 100 and the failed chunks are reported as
+// ChunkErrors. The users are returned in the order of the ids.
 //
 // Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/support/users#show-many-users
 func (c *client) ShowManyUsers(ids []int64) ([]User, error) {
-	var sids []string
-	for _, id := range ids {
-		sids = append(sids, strconv.FormatInt(id, 10))
-	}
+	return c.ShowManyUsersContext(context.Background(), ids)
+}
 
-	out := new(APIPayload)
-	err := c.get(fmt.Sprintf("/api/v2/users/show_many.json?ids=%s", strings.Join(sids, ",")), out)
-	return out.Users, err
+// ShowManyUsersContext is like ShowManyUsers but uses ctx for the underlying request.
+func (c *client) ShowManyUsersContext(ctx context.Context, ids []int64) ([]User, error) {
+	users, err := chunked(ctx, c, ids, func(ctx context.Context, ids []int64) ([]User, error) {
+		var sids []string
+		for _, id := range ids {
+			sids = append(sids, strconv.FormatInt(id, 10))
+		}
+
+		out := new(APIPayload)
+		err := c.get(ctx, "ShowManyUsers", routef("/api/v2/users/show_many.json?ids=%s", strings.Join(sids, ",")), out)
+		return out.Users, err
+	})
+
+	sortByInput(users, ids, func(user *User) (int64, bool) {
+		if user.ID == nil {
+			return 0, false
+		}
+		return *user.ID, true
+	})
+	return users, err
 }
+
 // ShowManyUsersByExternalIDs accepts a comma-separated list of external ids.
+// Like ShowManyUsers, the external ids are sent in chunks of 100 and the users
+// are returned in the order of the external ids.
 //
 // Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/support/users#show-many-users
 func (c *client) ShowManyUsersByExternalIDs(externalIds []string) ([]User, error) {
-	out := new(APIPayload)
-	err := c.get(fmt.Sprintf("/api/v2/users/show_many.json?external_ids=%s", strings.Join(externalIds, ",")), out)
-	return out.Users, err
+	return c.ShowManyUsersByExternalIDsContext(context.Background(), externalIds)
+}
+
+// ShowManyUsersByExternalIDsContext is like ShowManyUsersByExternalIDs but uses ctx for the underlying request.
+func (c *client) ShowManyUsersByExternalIDsContext(ctx context.Context, externalIds []string) ([]User, error) {
+	users, err := chunked(ctx, c, externalIds, func(ctx context.Context, externalIds []string) ([]User, error) {
+		out := new(APIPayload)
+		err := c.get(ctx, "ShowManyUsersByExternalIDs", routef("/api/v2/users/show_many.json?external_ids=%s", strings.Join(externalIds, ",")), out)
+		return out.Users, err
+	})
+
+	sortByInput(users, externalIds, func(user *User) (string, bool) {
+		if user.ExternalID == nil {
+			return "", false
+		}
+		return *user.ExternalID, true
+	})
+	return users, err
 }
 
 // CreateUser creates a user.
 //
 // Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/core/users#create-user
 func (c *client) CreateUser(user *User) (*User, error) {
+	return c.CreateUserContext(context.Background(), user)
+}
+
+// CreateUserContext is like CreateUser but uses ctx for the underlying request.
+func (c *client) CreateUserContext(ctx context.Context, user *User) (*User, error) {
 	in := &APIPayload{User: user}
 	out := new(APIPayload)
-	err := c.post("/api/v2/users.json", in, out)
+	err := c.post(ctx, "CreateUser", routef("/api/v2/users.json"), in, out)
 	return out.User, err
 }
 
@@ -103,9 +146,14 @@ func (c *client) CreateUser(user *User) (*User, error) {
 //
 // Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/core/users#create-or-update-user
 func (c *client) CreateOrUpdateUser(user *User) (*User, error) {
+	return c.CreateOrUpdateUserContext(context.Background(), user)
+}
+
+// CreateOrUpdateUserContext is like CreateOrUpdateUser but uses ctx for the underlying request.
+func (c *client) CreateOrUpdateUserContext(ctx context.Context, user *User) (*User, error) {
 	in := &APIPayload{User: user}
 	out := new(APIPayload)
-	err := c.post("/api/v2/users/create_or_update.json", in, out)
+	err := c.post(ctx, "CreateOrUpdateUser", routef("/api/v2/users/create_or_update.json"), in, out)
 	return out.User, err
 }
 
@@ -113,9 +161,14 @@ func (c *client) CreateOrUpdateUser(user *User) (*User, error) {
 //
 // Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/core/users#update-user
 func (c *client) UpdateUser(id int64, user *User) (*User, error) {
+	return c.UpdateUserContext(context.Background(), id, user)
+}
+
+// UpdateUserContext is like UpdateUser but uses ctx for the underlying request.
+func (c *client) UpdateUserContext(ctx context.Context, id int64, user *User) (*User, error) {
 	in := &APIPayload{User: user}
 	out := new(APIPayload)
-	err := c.put(fmt.Sprintf("/api/v2/users/%d.json", id), in, out)
+	err := c.put(ctx, "UpdateUser", routef("/api/v2/users/%d.json", id), in, out)
 	return out.User, err
 }
 
@@ -123,8 +176,13 @@ func (c *client) UpdateUser(id int64, user *User) (*User, error) {
 //
 // Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/core/users#delete-user
 func (c *client) DeleteUser(id int64) (*User, error) {
+	return c.DeleteUserContext(context.Background(), id)
+}
+
+// DeleteUserContext is like DeleteUser but uses ctx for the underlying request.
+func (c *client) DeleteUserContext(ctx context.Context, id int64) (*User, error) {
 	out := new(APIPayload)
-	err := c.delete(fmt.Sprintf("/api/v2/users/%d.json", id), out)
+	err := c.delete(ctx, "DeleteUser", routef("/api/v2/users/%d.json", id), out)
 	return out.User, err
 }
 
@@ -132,11 +190,87 @@ func (c *client) DeleteUser(id int64) (*User, error) {
 //
 // Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/core/users#permanently-delete-user
 func (c *client) PermanentlyDeleteUser(id int64) (*User, error) {
+	return c.PermanentlyDeleteUserContext(context.Background(), id)
+}
+
+// PermanentlyDeleteUserContext is like PermanentlyDeleteUser but uses ctx for the underlying request.
+func (c *client) PermanentlyDeleteUserContext(ctx context.Context, id int64) (*User, error) {
 	out := new(APIPayload)
-	err := c.delete(fmt.Sprintf("/api/v2/deleted_users/%d.json", id), out)
+	err := c.delete(ctx, "PermanentlyDeleteUser", routef("/api/v2/deleted_users/%d.json", id), out)
 	return out.User, err
 }
 
+// CreateManyUsers creates users in background jobs, one for each chunk of 100
+// users.
+//
+// Zendesk Core API docs: https://developer.zendesk.com/api-reference/ticketing/users/users/#create-many-users
+func (c *client) CreateManyUsers(users []User) ([]JobStatus, error) {
+	return c.CreateManyUsersContext(context.Background(), users)
+}
+
+// CreateManyUsersContext is like CreateManyUsers but uses ctx for the underlying request.
+func (c *client) CreateManyUsersContext(ctx context.Context, users []User) ([]JobStatus, error) {
+	return chunked(ctx, c, users, func(ctx context.Context, users []User) ([]JobStatus, error) {
+		in := &APIPayload{Users: users}
+		out := new(APIPayload)
+		err := c.post(ctx, "CreateManyUsers", routef("/api/v2/users/create_many.json"), in, out)
+		return jobStatuses(out, err)
+	})
+}
+
+// CreateOrUpdateManyUsers creates or updates users, matched by email or
+// external id, in background jobs, one for each chunk of 100 users.
+//
+// Zendesk Core API docs: https://developer.zendesk.com/api-reference/ticketing/users/users/#create-or-update-many-users
+func (c *client) CreateOrUpdateManyUsers(users []User) ([]JobStatus, error) {
+	return c.CreateOrUpdateManyUsersContext(context.Background(), users)
+}
+
+// CreateOrUpdateManyUsersContext is like CreateOrUpdateManyUsers but uses ctx for the underlying request.
+func (c *client) CreateOrUpdateManyUsersContext(ctx context.Context, users []User) ([]JobStatus, error) {
+	return chunked(ctx, c, users, func(ctx context.Context, users []User) ([]JobStatus, error) {
+		in := &APIPayload{Users: users}
+		out := new(APIPayload)
+		err := c.post(ctx, "CreateOrUpdateManyUsers", routef("/api/v2/users/create_or_update_many.json"), in, out)
+		return jobStatuses(out, err)
+	})
+}
+
+// UpdateManyUsers updates users, identified by their id, in background jobs,
+// one for each chunk of 100 users.
+//
+// Zendesk Core API docs: https://developer.zendesk.com/api-reference/ticketing/users/users/#update-many-users
+func (c *client) UpdateManyUsers(users []User) ([]JobStatus, error) {
+	return c.UpdateManyUsersContext(context.Background(), users)
+}
+
+// UpdateManyUsersContext is like UpdateManyUsers but uses ctx for the underlying request.
+func (c *client) UpdateManyUsersContext(ctx context.Context, users []User) ([]JobStatus, error) {
+	return chunked(ctx, c, users, func(ctx context.Context, users []User) ([]JobStatus, error) {
+		in := &APIPayload{Users: users}
+		out := new(APIPayload)
+		err := c.put(ctx, "UpdateManyUsers", routef("/api/v2/users/update_many.json"), in, out)
+		return jobStatuses(out, err)
+	})
+}
+
+// DestroyManyUsers deletes users in background jobs, one for each chunk of 100
+// users.
+//
+// Zendesk Core API docs: https://developer.zendesk.com/api-reference/ticketing/users/users/#bulk-delete-users
+func (c *client) DestroyManyUsers(ids []int64) ([]JobStatus, error) {
+	return c.DestroyManyUsersContext(context.Background(), ids)
+}
+
+// DestroyManyUsersContext is like DestroyManyUsers but uses ctx for the underlying request.
+func (c *client) DestroyManyUsersContext(ctx context.Context, ids []int64) ([]JobStatus, error) {
+	return chunked(ctx, c, ids, func(ctx context.Context, ids []int64) ([]JobStatus, error) {
+		out := new(APIPayload)
+		err := c.delete(ctx, "DestroyManyUsers", routef("/api/v2/users/destroy_many.json?ids=%s", joinIDs(ids)), out)
+		return jobStatuses(out, err)
+	})
+}
+
 // ListUsersOptions specifies the optional parameters for the list users methods.
 type ListUsersOptions struct {
 	ListOptions
@@ -149,36 +283,100 @@ type ListUsersOptions struct {
 //
 // Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/core/users#list-users
 func (c *client) ListOrganizationUsers(id int64, opts *ListUsersOptions) ([]User, error) {
-	params, err := query.Values(opts)
+	return c.ListOrganizationUsersContext(context.Background(), id, opts)
+}
+
+// ListOrganizationUsersContext is like ListOrganizationUsers but uses ctx for the underlying request.
+func (c *client) ListOrganizationUsersContext(ctx context.Context, id int64, opts *ListUsersOptions) ([]User, error) {
+	params, err := listParams(opts)
 	if err != nil {
 		return nil, err
 	}
 
 	out := new(APIPayload)
-	err = c.get(fmt.Sprintf("/api/v2/organizations/%d/users.json?%s", id, params.Encode()), out)
+	err = c.get(ctx, "ListOrganizationUsers", routef("/api/v2/organizations/%d/users.json?%s", id, params.Encode()), out)
 	return out.Users, err
 }
 
+// ListOrganizationUsersPager is like ListOrganizationUsers but returns a Pager over all the pages.
+func (c *client) ListOrganizationUsersPager(id int64, opts *ListUsersOptions) *Pager[User] {
+	params, err := listParams(opts)
+	rt := routef("/api/v2/organizations/%d/users.json?%s", id, params.Encode())
+	return newURLPager(c, "ListOrganizationUsersPager", rt, err, func(out *APIPayload) []User { return out.Users })
+}
+
 // ListUsers list of all users.
 //
 // Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/core/users#list-users
 func (c *client) ListUsers(opts *ListUsersOptions) ([]User, error) {
-	params, err := query.Values(opts)
+	return c.ListUsersContext(context.Background(), opts)
+}
+
+// ListUsersContext is like ListUsers but uses ctx for the underlying request.
+func (c *client) ListUsersContext(ctx context.Context, opts *ListUsersOptions) ([]User, error) {
+	params, err := listParams(opts)
 	if err != nil {
 		return nil, err
 	}
 
 	out := new(APIPayload)
-	err = c.get(fmt.Sprintf("/api/v2/users.json?%s", params.Encode()), out)
+	err = c.get(ctx, "ListUsers", routef("/api/v2/users.json?%s", params.Encode()), out)
 	return out.Users, err
 }
 
+// ListUsersPager is like ListUsers but returns a Pager over all the pages.
+func (c *client) ListUsersPager(opts *ListUsersOptions) *Pager[User] {
+	params, err := listParams(opts)
+	rt := routef("/api/v2/users.json?%s", params.Encode())
+	return newURLPager(c, "ListUsersPager", rt, err, func(out *APIPayload) []User { return out.Users })
+}
+
+// ListUsersCursorOptions specifies the optional parameters for the list users
+// methods using cursor pagination.
+type ListUsersCursorOptions struct {
+	CursorOptions
+
+	Role          []string `url:"role,omitempty"`
+	PermissionSet int64    `url:"permission_set,omitempty"`
+}
+
+// ListUsersCursor lists all users using cursor pagination.
+//
+// Zendesk Core API docs: https://developer.zendesk.com/api-reference/introduction/pagination/#using-cursor-pagination
+func (c *client) ListUsersCursor(options *ListUsersCursorOptions) (*ListResponse, error) {
+	return c.ListUsersCursorContext(context.Background(), options)
+}
+
+// ListUsersCursorContext is like ListUsersCursor but uses ctx for the underlying request.
+func (c *client) ListUsersCursorContext(ctx context.Context, options *ListUsersCursorOptions) (*ListResponse, error) {
+	params, err := listParams(options)
+	if err != nil {
+		return nil, err
+	}
+
+	out := new(APIPayload)
+	err = c.get(ctx, "ListUsersCursor", routef("/api/v2/users.json?%s", params.Encode()), out)
+	if err != nil {
+		return nil, err
+	}
+	return &ListResponse{
+		Users: out.Users,
+		Meta:  out.Meta,
+		Links: out.Links,
+	}, nil
+}
+
 // SearchUsers searches users by name or email address.
 //
 // Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/core/users#search-users
 func (c *client) SearchUsers(query string) ([]User, error) {
+	return c.SearchUsersContext(context.Background(), query)
+}
+
+// SearchUsersContext is like SearchUsers but uses ctx for the underlying request.
+func (c *client) SearchUsersContext(ctx context.Context, query string) ([]User, error) {
 	out := new(APIPayload)
-	err := c.get("/api/v2/users/search.json?query="+query, out)
+	err := c.get(ctx, "SearchUsers", routef("/api/v2/users/search.json?query=%s", query), out)
 	return out.Users, err
 }
 
@@ -186,8 +384,13 @@ func (c *client) SearchUsers(query string) ([]User, error) {
 //
 // Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/core/users#search-users
 func (c *client) SearchUserByExternalID(externalID string) (*User, error) {
+	return c.SearchUserByExternalIDContext(context.Background(), externalID)
+}
+
+// SearchUserByExternalIDContext is like SearchUserByExternalID but uses ctx for the underlying request.
+func (c *client) SearchUserByExternalIDContext(ctx context.Context, externalID string) (*User, error) {
 	out := new(APIPayload)
-	err := c.get("/api/v2/users/search.json?external_id="+externalID, out)
+	err := c.get(ctx, "SearchUserByExternalID", routef("/api/v2/users/search.json?external_id=%s", externalID), out)
 	if len(out.Users) != 1 {
 		return nil, err
 	}
@@ -198,9 +401,14 @@ func (c *client) SearchUserByExternalID(externalID string) (*User, error) {
 //
 // Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/core/tags#add-tags
 func (c *client) AddUserTags(id int64, tags []string) ([]string, error) {
+	return c.AddUserTagsContext(context.Background(), id, tags)
+}
+
+// AddUserTagsContext is like AddUserTags but uses ctx for the underlying request.
+func (c *client) AddUserTagsContext(ctx context.Context, id int64, tags []string) ([]string, error) {
 	in := &APIPayload{Tags: tags}
 	out := new(APIPayload)
-	err := c.put(fmt.Sprintf("/api/v2/users/%d/tags.json", id), in, out)
+	err := c.put(ctx, "AddUserTags", routef("/api/v2/users/%d/tags.json", id), in, out)
 	return out.Tags, err
 }
 
@@ -208,9 +416,14 @@ func (c *client) AddUserTags(id int64, tags []string) ([]string, error) {
 //
 // Zendesk Core API docs:https://developer.zendesk.com/rest_api/docs/core/users#show-compliance-deletion-statuses
 func (c *client) ShowComplianceDeletionStatuses(id int64) ([]ComplianceDeletionStatus, error) {
+	return c.ShowComplianceDeletionStatusesContext(context.Background(), id)
+}
+
+// ShowComplianceDeletionStatusesContext is like ShowComplianceDeletionStatuses but uses ctx for the underlying request.
+func (c *client) ShowComplianceDeletionStatusesContext(ctx context.Context, id int64) ([]ComplianceDeletionStatus, error) {
 	out := new(APIPayload)
-	err := c.get(
-		fmt.Sprintf("/api/v2/users/%d/compliance_deletion_statuses.json", id),
+	err := c.get(ctx, "ShowComplianceDeletionStatuses",
+		routef("/api/v2/users/%d/compliance_deletion_statuses.json", id),
 		out)
 	return out.ComplianceDeletionStatuses, err
 }
@@ -1,7 +1,7 @@
 package zendesk
 
 import (
-	"fmt"
+	"context"
 	"time"
 )
 
@@ -26,8 +26,13 @@ type UserIdentity struct {
 //
 // Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/core/user_identities#list-identities
 func (c *client) ListIdentities(userID int64) ([]UserIdentity, error) {
+	return c.ListIdentitiesContext(context.Background(), userID)
+}
+
+// ListIdentitiesContext is like ListIdentities but uses ctx for the underlying request.
+func (c *client) ListIdentitiesContext(ctx context.Context, userID int64) ([]UserIdentity, error) {
 	out := new(APIPayload)
-	err := c.get(fmt.Sprintf("/api/v2/users/%d/identities.json", userID), out)
+	err := c.get(ctx, "ListIdentities", routef("/api/v2/users/%d/identities.json", userID), out)
 	return out.Identities, err
 }
 
@@ -35,8 +40,13 @@ func (c *client) ListIdentities(userID int64) ([]UserIdentity, error) {
 //
 // Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/core/user_identities#show-identity
 func (c *client) ShowIdentity(userID, id int64) (*UserIdentity, error) {
+	return c.ShowIdentityContext(context.Background(), userID, id)
+}
+
+// ShowIdentityContext is like ShowIdentity but uses ctx for the underlying request.
+func (c *client) ShowIdentityContext(ctx context.Context, userID, id int64) (*UserIdentity, error) {
 	out := new(APIPayload)
-	err := c.get(fmt.Sprintf("/api/v2/users/%d/identities/%d.json", userID, id), out)
+	err := c.get(ctx, "ShowIdentity", routef("/api/v2/users/%d/identities/%d.json", userID, id), out)
 	return out.Identity, err
 }
 
@@ -44,9 +54,14 @@ func (c *client) ShowIdentity(userID, id int64) (*UserIdentity, error) {
 //
 // Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/core/user_identities#create-identity
 func (c *client) CreateIdentity(userID int64, identity *UserIdentity) (*UserIdentity, error) {
+	return c.CreateIdentityContext(context.Background(), userID, identity)
+}
+
+// CreateIdentityContext is like CreateIdentity but uses ctx for the underlying request.
+func (c *client) CreateIdentityContext(ctx context.Context, userID int64, identity *UserIdentity) (*UserIdentity, error) {
 	in := &APIPayload{Identity: identity}
 	out := new(APIPayload)
-	err := c.post(fmt.Sprintf("/api/v2/users/%d/identities.json", userID), in, out)
+	err := c.post(ctx, "CreateIdentity", routef("/api/v2/users/%d/identities.json", userID), in, out)
 	return out.Identity, err
 }
 
@@ -54,9 +69,14 @@ func (c *client) CreateIdentity(userID int64, identity *UserIdentity) (*UserIden
 //
 // Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/core/user_identities#update-identity
 func (c *client) UpdateIdentity(userID, id int64, identity *UserIdentity) (*UserIdentity, error) {
+	return c.UpdateIdentityContext(context.Background(), userID, id, identity)
+}
+
+// UpdateIdentityContext is like UpdateIdentity but uses ctx for the underlying request.
+func (c *client) UpdateIdentityContext(ctx context.Context, userID, id int64, identity *UserIdentity) (*UserIdentity, error) {
 	in := &APIPayload{Identity: identity}
 	out := new(APIPayload)
-	err := c.put(fmt.Sprintf("/api/v2/users/%d/identities/%d.json", userID, id), in, out)
+	err := c.put(ctx, "UpdateIdentity", routef("/api/v2/users/%d/identities/%d.json", userID, id), in, out)
 	return out.Identity, err
 }
 
@@ -64,14 +84,24 @@ func (c *client) UpdateIdentity(userID, id int64, identity *UserIdentity) (*User
 //
 // Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/core/user_identities#delete-identity
 func (c *client) DeleteIdentity(userID, id int64) error {
-	return c.delete(fmt.Sprintf("/api/v2/users/%d/identities/%d.json", userID, id), nil)
+	return c.DeleteIdentityContext(context.Background(), userID, id)
+}
+
+// DeleteIdentityContext is like DeleteIdentity but uses ctx for the underlying request.
+func (c *client) DeleteIdentityContext(ctx context.Context, userID, id int64) error {
+	return c.delete(ctx, "DeleteIdentity", routef("/api/v2/users/%d/identities/%d.json", userID, id), nil)
 }
 
 // MakeIdentityPrimary makes a user identity primary.
 //
 // Zendesk Core API docs: https://developer.zendesk.com/rest_api/docs/support/user_identities#make-identity-primary
 func (c *client) MakeIdentityPrimary(userID, id int64) ([]UserIdentity, error) {
+	return c.MakeIdentityPrimaryContext(context.Background(), userID, id)
+}
+
+// MakeIdentityPrimaryContext is like MakeIdentityPrimary but uses ctx for the underlying request.
+func (c *client) MakeIdentityPrimaryContext(ctx context.Context, userID, id int64) ([]UserIdentity, error) {
 	out := new(APIPayload)
-	err := c.put(fmt.Sprintf("/api/v2/users/%d/identities/%d/make_primary.json", userID, id), nil, out)
+	err := c.put(ctx, "MakeIdentityPrimary", routef("/api/v2/users/%d/identities/%d/make_primary.json", userID, id), nil, out)
 	return out.Identities, err
 }
@@ -2,6 +2,8 @@ package zendesk
 
 import (
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -178,3 +180,45 @@ func TestSearchUsersEx(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 1, *found.Count)
 }
+
+func TestListUsersCursor(t *testing.T) {
+	var requested []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = append(requested, r.URL.Query().Encode())
+
+		if r.URL.Query().Get("page[after]") == "" {
+			fmt.Fprint(w, `{"users":[{"id":1},{"id":2}],"meta":{"has_more":true,"after_cursor":"xyz","before_cursor":"abc"},"links":{"next":"https://example.zendesk.com/api/v2/users.json?page%5Bafter%5D=xyz&page%5Bsize%5D=2","prev":null}}`)
+			return
+		}
+		fmt.Fprint(w, `{"users":[{"id":3}],"meta":{"has_more":false,"after_cursor":null,"before_cursor":"def"},"links":{"next":null,"prev":null}}`)
+	}))
+	defer server.Close()
+
+	client, err := NewURLClient(server.URL, "", "")
+	require.NoError(t, err)
+
+	options := &ListUsersCursorOptions{CursorOptions: CursorOptions{PageSize: 2}, Role: []string{"agent"}}
+
+	var ids []int64
+	for {
+		res, err := client.ListUsersCursor(options)
+		require.NoError(t, err)
+
+		for _, user := range res.Users {
+			ids = append(ids, *user.ID)
+		}
+
+		if !res.Meta.HasMore {
+			require.Nil(t, res.Links.Next)
+			break
+		}
+		require.NotNil(t, res.Links.Next)
+		options.After = *res.Meta.AfterCursor
+	}
+
+	require.Equal(t, []int64{1, 2, 3}, ids)
+	require.Equal(t, []string{
+		"page%5Bsize%5D=2&role=agent",
+		"page%5Bafter%5D=xyz&page%5Bsize%5D=2&role=agent",
+	}, requested)
+}
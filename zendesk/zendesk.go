@@ -4,25 +4,154 @@ package zendesk
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"iter"
 	"net/http"
 	"net/url"
 	"os"
-	"strconv"
+	"regexp"
+	"strings"
 	"time"
 )
 
+// ContextClient describes the context-aware variants of the Client methods.
+//
+// Each method behaves like its Client counterpart, but the request is bound to
+// the provided context so it can be cancelled or given a deadline.
+type ContextClient interface {
+	AddUserTagsContext(context.Context, int64, []string) ([]string, error)
+	AutocompleteOrganizationsContext(context.Context, string) ([]Organization, error)
+	BatchUpdateManyTicketsContext(context.Context, []Ticket) error
+	BulkUpdateManyTicketsContext(context.Context, []int64, *Ticket) ([]JobStatus, error)
+	CreateIdentityContext(context.Context, int64, *UserIdentity) (*UserIdentity, error)
+	CreateOrganizationContext(context.Context, *Organization) (*Organization, error)
+	CreateOrganizationMembershipContext(context.Context, *OrganizationMembership) (*OrganizationMembership, error)
+	CreateOrUpdateOrganizationContext(context.Context, *Organization) (*Organization, error)
+	CreateOrUpdateUserContext(context.Context, *User) (*User, error)
+	CreateTicketContext(context.Context, *Ticket) (*Ticket, error)
+	CreateUserContext(context.Context, *User) (*User, error)
+	CreateGroupContext(context.Context, *Group) (*Group, error)
+	DeleteIdentityContext(context.Context, int64, int64) error
+	DeleteOrganizationContext(context.Context, int64) error
+	DeleteTicketContext(context.Context, int64) error
+	DeleteUserContext(context.Context, int64) (*User, error)
+	DeleteOrganizationMembershipByIDContext(context.Context, int64) error
+	DeleteGroupContext(context.Context, int64) error
+	ListIdentitiesContext(context.Context, int64) ([]UserIdentity, error)
+	ListLocalesContext(context.Context) ([]Locale, error)
+	ListOrganizationMembershipsByUserIDContext(context.Context, int64) ([]OrganizationMembership, error)
+	ListOrganizationsContext(context.Context, *ListOptions) ([]Organization, error)
+	ListOrganizationUsersContext(context.Context, int64, *ListUsersOptions) ([]User, error)
+	ListOrganizationTicketsContext(context.Context, int64, *ListOptions, ...SideLoad) (*ListResponse, error)
+	ListExternalIDTicketsContext(context.Context, string, *ListOptions, ...SideLoad) (*ListResponse, error)
+	ListRequestedTicketsContext(context.Context, int64) ([]Ticket, error)
+	ListTicketsContext(context.Context, *ListOptions, ...SideLoad) (*ListResponse, error)
+	ListTicketAuditsContext(context.Context, int64, *ListOptions) (*ListResponse, error)
+	ListTicketCommentsContext(context.Context, int64) ([]TicketComment, error)
+	ListTicketCommentsFullContext(context.Context, int64, *ListOptions, ...SideLoad) (*ListResponse, error)
+	ListTicketCollaboratorsContext(context.Context, int64) ([]User, error)
+	ListTicketFollowersContext(context.Context, int64) ([]User, error)
+	ListTicketEmailCCsContext(context.Context, int64) ([]User, error)
+	ListTicketFieldsContext(context.Context) ([]TicketField, error)
+	ListTicketIncidentsContext(context.Context, int64) ([]Ticket, error)
+	ListUsersContext(context.Context, *ListUsersOptions) ([]User, error)
+	ListGroupsContext(context.Context) ([]Group, error)
+	MakeIdentityPrimaryContext(context.Context, int64, int64) ([]UserIdentity, error)
+	PermanentlyDeleteTicketContext(context.Context, int64) (*JobStatus, error)
+	PermanentlyDeleteUserContext(context.Context, int64) (*User, error)
+	RedactCommentStringContext(context.Context, int64, int64, string) (*TicketComment, error)
+	SearchOrganizationsByExternalIDContext(context.Context, string) ([]Organization, error)
+	SearchTicketsContext(context.Context, string, *ListOptions, ...Filters) (*TicketSearchResults, error)
+	SearchUsersContext(context.Context, string) ([]User, error)
+	SearchUsersExContext(context.Context, string, *ListOptions, ...Filters) (*UserSearchResults, error)
+	SearchUserByExternalIDContext(context.Context, string) (*User, error)
+	ShowComplianceDeletionStatusesContext(context.Context, int64) ([]ComplianceDeletionStatus, error)
+	ShowIdentityContext(context.Context, int64, int64) (*UserIdentity, error)
+	ShowJobStatusContext(context.Context, string) (*JobStatus, error)
+	ShowLocaleContext(context.Context, int64) (*Locale, error)
+	ShowLocaleByCodeContext(context.Context, string) (*Locale, error)
+	ShowManyOrganizationsContext(context.Context, []int64) ([]Organization, error)
+	ShowManyUsersContext(context.Context, []int64) ([]User, error)
+	ShowManyUsersByExternalIDsContext(context.Context, []string) ([]User, error)
+	ShowOrganizationContext(context.Context, int64) (*Organization, error)
+	ShowTicketContext(context.Context, int64) (*Ticket, error)
+	ShowUserContext(context.Context, int64) (*User, error)
+	ShowGroupContext(context.Context, int64) (*Group, error)
+	UpdateIdentityContext(context.Context, int64, int64, *UserIdentity) (*UserIdentity, error)
+	UpdateOrganizationContext(context.Context, int64, *Organization) (*Organization, error)
+	UpdateTicketContext(context.Context, int64, *Ticket) (*Ticket, error)
+	UpdateUserContext(context.Context, int64, *User) (*User, error)
+	UploadFileContext(context.Context, string, *string, io.Reader) (*Upload, error)
+	UpdateGroupContext(context.Context, int64, *Group) (*Group, error)
+	ListOAuthClientsContext(context.Context) ([]OAuthClient, error)
+	ShowOAuthClientContext(context.Context, int64) (*OAuthClient, error)
+	CreateOAuthClientContext(context.Context, *OAuthClient) (*OAuthClient, error)
+	UpdateOAuthClientContext(context.Context, int64, *OAuthClient) (*OAuthClient, error)
+	DeleteOAuthClientContext(context.Context, int64) error
+	ListOAuthTokensContext(context.Context, *ListOAuthTokensOptions) ([]OAuthToken, error)
+	ShowOAuthTokenContext(context.Context, int64) (*OAuthToken, error)
+	CreateOAuthTokenContext(context.Context, *OAuthToken) (*OAuthToken, error)
+	RevokeOAuthTokenContext(context.Context, int64) error
+	ListTicketsCursorContext(context.Context, *CursorOptions, ...SideLoad) (*ListResponse, error)
+	ListUsersCursorContext(context.Context, *ListUsersCursorOptions) (*ListResponse, error)
+	ListOrganizationsCursorContext(context.Context, *CursorOptions) (*ListResponse, error)
+	ListTicketAuditsCursorContext(context.Context, int64, *CursorOptions) (*ListResponse, error)
+	ListTicketCommentsCursorContext(context.Context, int64, *CursorOptions, ...SideLoad) (*ListResponse, error)
+	ListOrganizationMembershipsCursorContext(context.Context, *CursorOptions) (*ListResponse, error)
+	IncrementalTicketsContext(context.Context, *IncrementalOptions, ...SideLoad) (*IncrementalExport, error)
+	IncrementalUsersContext(context.Context, *IncrementalOptions, ...SideLoad) (*IncrementalExport, error)
+	IncrementalOrganizationsContext(context.Context, *IncrementalOptions, ...SideLoad) (*IncrementalExport, error)
+	IncrementalTicketEventsContext(context.Context, *IncrementalOptions, ...SideLoad) (*IncrementalExport, error)
+	IncrementalTicketMetricEventsContext(context.Context, *IncrementalOptions) (*IncrementalExport, error)
+	ShowManyJobStatusesContext(context.Context, []string) ([]JobStatus, error)
+	ListJobStatusesContext(context.Context) ([]JobStatus, error)
+	CreateManyUsersContext(context.Context, []User) ([]JobStatus, error)
+	CreateOrUpdateManyUsersContext(context.Context, []User) ([]JobStatus, error)
+	UpdateManyUsersContext(context.Context, []User) ([]JobStatus, error)
+	DestroyManyUsersContext(context.Context, []int64) ([]JobStatus, error)
+	CreateManyOrganizationsContext(context.Context, []Organization) ([]JobStatus, error)
+	UpdateManyOrganizationsContext(context.Context, []Organization) ([]JobStatus, error)
+	DestroyManyOrganizationsContext(context.Context, []int64) ([]JobStatus, error)
+	CreateManyOrganizationMembershipsContext(context.Context, []OrganizationMembership) ([]JobStatus, error)
+	DestroyManyOrganizationMembershipsContext(context.Context, []int64) ([]JobStatus, error)
+	CreateManyTicketsContext(context.Context, []Ticket) ([]JobStatus, error)
+	UpdateManyTicketsContext(context.Context, []Ticket) ([]JobStatus, error)
+	DestroyManyTicketsContext(context.Context, []int64) ([]JobStatus, error)
+	MergeTicketsContext(context.Context, int64, *TicketMergeRequest) (*JobStatus, error)
+	ListTagsContext(context.Context, *ListOptions) ([]Tag, error)
+	AutocompleteTagsContext(context.Context, string) ([]string, error)
+	ListTicketTagsContext(context.Context, int64) ([]string, error)
+	SetTicketTagsContext(context.Context, int64, []string) ([]string, error)
+	AddTicketTagsContext(context.Context, int64, []string) ([]string, error)
+	RemoveTicketTagsContext(context.Context, int64, []string) ([]string, error)
+	ListOrganizationTagsContext(context.Context, int64) ([]string, error)
+	SetOrganizationTagsContext(context.Context, int64, []string) ([]string, error)
+	AddOrganizationTagsContext(context.Context, int64, []string) ([]string, error)
+	RemoveOrganizationTagsContext(context.Context, int64, []string) ([]string, error)
+	ListUserTagsContext(context.Context, int64) ([]string, error)
+	SetUserTagsContext(context.Context, int64, []string) ([]string, error)
+	RemoveUserTagsContext(context.Context, int64, []string) ([]string, error)
+	SearchOrganizationsContext(context.Context, string, *ListOptions, ...Filters) (*OrganizationSearchResults, error)
+	SearchGroupsContext(context.Context, string, *ListOptions, ...Filters) (*GroupSearchResults, error)
+	SearchContext(context.Context, string, *ListOptions) (*SearchResults, error)
+	SearchExportContext(context.Context, string, ResultType, *CursorOptions) (*SearchExportResults, error)
+	CountSearchContext(context.Context, string) (int64, error)
+}
+
 // Client describes a client for the Zendesk Core API.
 type Client interface {
+	ContextClient
+
 	WithHeader(name, value string) Client
 
 	AddUserTags(int64, []string) ([]string, error)
 	AutocompleteOrganizations(string) ([]Organization, error)
 	BatchUpdateManyTickets([]Ticket) error
-	BulkUpdateManyTickets([]int64, *Ticket) error
+	BulkUpdateManyTickets([]int64, *Ticket) ([]JobStatus, error)
 	CreateIdentity(int64, *UserIdentity) (*UserIdentity, error)
 	CreateOrganization(*Organization) (*Organization, error)
 	CreateOrganizationMembership(*OrganizationMembership) (*OrganizationMembership, error)
@@ -83,16 +212,88 @@ type Client interface {
 	UpdateUser(int64, *User) (*User, error)
 	UploadFile(string, *string, io.Reader) (*Upload, error)
 	UpdateGroup(int64, *Group) (*Group, error)
+	ListOAuthClients() ([]OAuthClient, error)
+	ShowOAuthClient(int64) (*OAuthClient, error)
+	CreateOAuthClient(*OAuthClient) (*OAuthClient, error)
+	UpdateOAuthClient(int64, *OAuthClient) (*OAuthClient, error)
+	DeleteOAuthClient(int64) error
+	ListOAuthTokens(*ListOAuthTokensOptions) ([]OAuthToken, error)
+	ShowOAuthToken(int64) (*OAuthToken, error)
+	CreateOAuthToken(*OAuthToken) (*OAuthToken, error)
+	RevokeOAuthToken(int64) error
+	WaitForJob(context.Context, string, time.Duration) (*JobStatus, error)
+	SearchAllTickets(context.Context, string, *WindowSearchOptions, ...Filters) iter.Seq2[Ticket, error]
+	ListGroupsPager(*ListOptions) *Pager[Group]
+	ListOrganizationsPager(*ListOptions) *Pager[Organization]
+	ListOrganizationTicketsPager(int64, *ListOptions, ...SideLoad) *Pager[Ticket]
+	ListOrganizationUsersPager(int64, *ListUsersOptions) *Pager[User]
+	ListExternalIDTicketsPager(string, *ListOptions, ...SideLoad) *Pager[Ticket]
+	ListTicketAuditsPager(int64, *ListOptions) *Pager[TicketAudit]
+	ListTicketCommentsPager(int64, *ListOptions, ...SideLoad) *Pager[TicketComment]
+	ListTicketsPager(*ListOptions, ...SideLoad) *Pager[Ticket]
+	ListUsersPager(*ListUsersOptions) *Pager[User]
+	IncrementalTicketEventsPager(*IncrementalOptions, ...SideLoad) *Pager[TicketEvent]
+	IncrementalTicketMetricEventsPager(*IncrementalOptions) *Pager[TicketMetricEvent]
+	ListTicketsCursor(*CursorOptions, ...SideLoad) (*ListResponse, error)
+	ListUsersCursor(*ListUsersCursorOptions) (*ListResponse, error)
+	ListOrganizationsCursor(*CursorOptions) (*ListResponse, error)
+	ListTicketAuditsCursor(int64, *CursorOptions) (*ListResponse, error)
+	ListTicketCommentsCursor(int64, *CursorOptions, ...SideLoad) (*ListResponse, error)
+	ListOrganizationMembershipsCursor(*CursorOptions) (*ListResponse, error)
+	IncrementalTickets(*IncrementalOptions, ...SideLoad) (*IncrementalExport, error)
+	IncrementalUsers(*IncrementalOptions, ...SideLoad) (*IncrementalExport, error)
+	IncrementalOrganizations(*IncrementalOptions, ...SideLoad) (*IncrementalExport, error)
+	IncrementalTicketEvents(*IncrementalOptions, ...SideLoad) (*IncrementalExport, error)
+	IncrementalTicketMetricEvents(*IncrementalOptions) (*IncrementalExport, error)
+	ShowManyJobStatuses([]string) ([]JobStatus, error)
+	ListJobStatuses() ([]JobStatus, error)
+	CreateManyUsers([]User) ([]JobStatus, error)
+	CreateOrUpdateManyUsers([]User) ([]JobStatus, error)
+	UpdateManyUsers([]User) ([]JobStatus, error)
+	DestroyManyUsers([]int64) ([]JobStatus, error)
+	CreateManyOrganizations([]Organization) ([]JobStatus, error)
+	UpdateManyOrganizations([]Organization) ([]JobStatus, error)
+	DestroyManyOrganizations([]int64) ([]JobStatus, error)
+	CreateManyOrganizationMemberships([]OrganizationMembership) ([]JobStatus, error)
+	DestroyManyOrganizationMemberships([]int64) ([]JobStatus, error)
+	CreateManyTickets([]Ticket) ([]JobStatus, error)
+	UpdateManyTickets([]Ticket) ([]JobStatus, error)
+	DestroyManyTickets([]int64) ([]JobStatus, error)
+	MergeTickets(int64, *TicketMergeRequest) (*JobStatus, error)
+	ListTags(*ListOptions) ([]Tag, error)
+	AutocompleteTags(string) ([]string, error)
+	ListTicketTags(int64) ([]string, error)
+	SetTicketTags(int64, []string) ([]string, error)
+	AddTicketTags(int64, []string) ([]string, error)
+	RemoveTicketTags(int64, []string) ([]string, error)
+	ListOrganizationTags(int64) ([]string, error)
+	SetOrganizationTags(int64, []string) ([]string, error)
+	AddOrganizationTags(int64, []string) ([]string, error)
+	RemoveOrganizationTags(int64, []string) ([]string, error)
+	ListUserTags(int64) ([]string, error)
+	SetUserTags(int64, []string) ([]string, error)
+	RemoveUserTags(int64, []string) ([]string, error)
+	SearchOrganizations(string, *ListOptions, ...Filters) (*OrganizationSearchResults, error)
+	SearchGroups(string, *ListOptions, ...Filters) (*GroupSearchResults, error)
+	Search(string, *ListOptions) (*SearchResults, error)
+	SearchExport(string, ResultType, *CursorOptions) (*SearchExportResults, error)
+	CountSearch(string) (int64, error)
 }
 
 type client struct {
-	username string
-	password string
+	auth authenticator
 
 	client    *http.Client
 	baseURL   *url.URL
 	userAgent string
 	headers   map[string]string
+	retry     *RetryPolicy
+	jitter    *jitterSource
+	limiter   *rateLimiter
+
+	middlewares []Middleware
+
+	chunkConcurrency int
 }
 
 type ClientOption func(*client)
@@ -105,19 +306,29 @@ func WithHTTPClient(httpClient *http.Client) ClientOption {
 
 // NewEnvClient creates a new Client configured via environment variables.
 //
-// Three environment variables are required: ZENDESK_DOMAIN, ZENDESK_USERNAME and ZENDESK_PASSWORD
-// they will provide parameters to the NewClient function
+// ZENDESK_DOMAIN is always required. The client authenticates with ZENDESK_OAUTH_TOKEN
+// when it's set, otherwise with ZENDESK_USERNAME and ZENDESK_API_TOKEN when the latter
+// is set, and with ZENDESK_USERNAME and ZENDESK_PASSWORD as a last resort.
+// They will provide parameters to the NewClient function.
 func NewEnvClient(opts ...ClientOption) (Client, error) {
 	domain := os.Getenv("ZENDESK_DOMAIN")
 	if domain == "" {
 		return nil, errors.New("ZENDESK_DOMAIN not found")
 	}
 
+	if token := os.Getenv("ZENDESK_OAUTH_TOKEN"); token != "" {
+		return NewClient(domain, "", "", append([]ClientOption{WithOAuthToken(token)}, opts...)...)
+	}
+
 	username := os.Getenv("ZENDESK_USERNAME")
 	if username == "" {
 		return nil, errors.New("ZENDESK_USERNAME not found")
 	}
 
+	if token := os.Getenv("ZENDESK_API_TOKEN"); token != "" {
+		return NewClient(domain, username, "", append([]ClientOption{WithAPIToken(username, token)}, opts...)...)
+	}
+
 	password := os.Getenv("ZENDESK_PASSWORD")
 	if password == "" {
 		return nil, errors.New("ZENDESK_PASSWORD not found")
@@ -129,25 +340,31 @@ func NewEnvClient(opts ...ClientOption) (Client, error) {
 // NewClient creates a new Client.
 //
 // You can use either a user email/password combination or an API token.
-// For the latter, append /token to the email and use the API token as a password
+// For the latter, append /token to the email and use the API token as a password,
+// or use the WithAPIToken option. OAuth access tokens are supported through the
+// WithOAuthToken and WithTokenSource options.
 func NewClient(domain, username, password string, opts ...ClientOption) (Client, error) {
 	return NewURLClient(fmt.Sprintf("https://%s.zendesk.com", domain), username, password, opts...)
 }
 
 // NewURLClient is like NewClient but accepts an explicit end point instead of a Zendesk domain.
 func NewURLClient(endpoint, username, password string, opts ...ClientOption) (Client, error) {
+	return newClient(endpoint, username, password, opts...)
+}
+
+func newClient(endpoint, username, password string, opts ...ClientOption) (*client, error) {
 	baseURL, err := url.Parse(endpoint)
 	if err != nil {
 		return nil, err
 	}
 
 	c := &client{
+		auth:      basicAuth(username, password),
 		baseURL:   baseURL,
 		userAgent: "Go-Zendesk",
-		username:  username,
-		password:  password,
 		client:    http.DefaultClient,
 		headers:   make(map[string]string),
+		jitter:    newJitterSource(),
 	}
 
 	for _, opt := range opts {
@@ -172,19 +389,50 @@ func (c *client) WithHeader(name, value string) Client {
 	return &newClient
 }
 
-func (c *client) request(method, endpoint string, headers map[string]string, body io.Reader) (*http.Response, error) {
-	rel, err := url.Parse(endpoint)
+// route is the endpoint of a request along with the pattern it was built from.
+type route struct {
+	endpoint string
+	template string
+}
+
+// routef formats the endpoint of a request like fmt.Sprintf. Its template is
+// the path of format with each verb replaced by {id}, so that requests for
+// different records of the same endpoint share a template.
+func routef(format string, args ...interface{}) route {
+	template, _, _ := strings.Cut(format, "?")
+	return route{
+		endpoint: fmt.Sprintf(format, args...),
+		template: routeVerb.ReplaceAllString(template, "{id}"),
+	}
+}
+
+// routeVerb matches the fmt verbs used in endpoint formats.
+var routeVerb = regexp.MustCompile(`%[a-z]`)
+
+func (c *client) request(ctx context.Context, op, method string, rt route, headers map[string]string, body io.Reader, attempt int) (*http.Response, error) {
+	rel, err := url.Parse(rt.endpoint)
 	if err != nil {
 		return nil, err
 	}
 
 	url := c.baseURL.ResolveReference(rel)
-	req, err := http.NewRequest(method, url.String(), body)
+	req, err := http.NewRequestWithContext(ctx, method, url.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
-	req.SetBasicAuth(c.username, c.password)
+	// Wait for the limiter first, so that the credentials aren't fetched long
+	// before they're sent.
+	if c.limiter != nil {
+		if err := c.limiter.wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := c.auth(ctx, req); err != nil {
+		return nil, err
+	}
+
 	req.Header.Set("User-Agent", c.userAgent)
 
 	for key, value := range c.headers {
@@ -195,10 +443,17 @@ func (c *client) request(method, endpoint string, headers map[string]string, bod
 		req.Header.Set(key, value)
 	}
 
-	return c.client.Do(req)
+	return c.handle(&Call{
+		Request:   req,
+		Endpoint:  rt.endpoint,
+		Template:  rt.template,
+		Operation: op,
+		Attempt:   attempt,
+	})
 }
 
-func (c *client) do(method, endpoint string, in, out interface{}) error {
+// do sends a request on behalf of the client method op, e.g. ShowTicket.
+func (c *client) do(ctx context.Context, op, method string, rt route, in, out interface{}) error {
 	payload, err := marshall(in)
 	if err != nil {
 		return err
@@ -209,47 +464,49 @@ func (c *client) do(method, endpoint string, in, out interface{}) error {
 		headers["Content-Type"] = "application/json"
 	}
 
-	res, err := c.request(method, endpoint, headers, bytes.NewReader(payload))
+	res, err := c.send(ctx, op, method, rt, headers, bytes.NewReader(payload))
 	if err != nil {
 		return err
 	}
 
 	defer res.Body.Close()
 
-	// Retry the request if the retry after header is present. This can happen when we are
-	// being rate limited or we failed with a retriable error.
-	if res.Header.Get("Retry-After") != "" {
-		after, err := strconv.ParseInt(res.Header.Get("Retry-After"), 10, 64)
-		if err != nil || after == 0 {
-			return unmarshall(res, out)
-		}
-
-		time.Sleep(time.Duration(after) * time.Second)
+	return unmarshall(res, out)
+}
 
-		res, err = c.request(method, endpoint, headers, bytes.NewReader(payload))
-		if err != nil {
-			return err
-		}
-		defer res.Body.Close()
-	}
+func (c *client) get(ctx context.Context, op string, rt route, out interface{}) error {
+	return c.do(ctx, op, "GET", rt, nil, out)
+}
 
-	return unmarshall(res, out)
+func (c *client) post(ctx context.Context, op string, rt route, in, out interface{}) error {
+	return c.do(ctx, op, "POST", rt, in, out)
 }
 
-func (c *client) get(endpoint string, out interface{}) error {
-	return c.do("GET", endpoint, nil, out)
+func (c *client) put(ctx context.Context, op string, rt route, in, out interface{}) error {
+	return c.do(ctx, op, "PUT", rt, in, out)
 }
 
-func (c *client) post(endpoint string, in, out interface{}) error {
-	return c.do("POST", endpoint, in, out)
+func (c *client) delete(ctx context.Context, op string, rt route, out interface{}) error {
+	return c.do(ctx, op, "DELETE", rt, nil, out)
 }
 
-func (c *client) put(endpoint string, in, out interface{}) error {
-	return c.do("PUT", endpoint, in, out)
+// deleteWithBody is like delete but sends in as the body of the request, which
+// the endpoints removing tags read them from.
+func (c *client) deleteWithBody(ctx context.Context, op string, rt route, in, out interface{}) error {
+	return c.do(ctx, op, "DELETE", rt, in, out)
 }
 
-func (c *client) delete(endpoint string, out interface{}) error {
-	return c.do("DELETE", endpoint, nil, out)
+// sleep pauses for the duration d or until ctx is done, whichever happens first.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
 }
 
 func marshall(in interface{}) ([]byte, error) {
@@ -262,13 +519,7 @@ func marshall(in interface{}) ([]byte, error) {
 
 func unmarshall(res *http.Response, out interface{}) error {
 	if res.StatusCode < 200 || res.StatusCode >= 300 {
-		apierr := new(APIError)
-		apierr.Response = res
-		if err := json.NewDecoder(res.Body).Decode(apierr); err != nil {
-			apierr.Type = String("Unknown")
-			apierr.Description = String("Oops! Something went wrong when parsing the error response.")
-		}
-		return apierr
+		return decodeAPIError(res)
 	}
 
 	if out != nil {
@@ -278,6 +529,25 @@ func unmarshall(res *http.Response, out interface{}) error {
 	return nil
 }
 
+func decodeAPIError(res *http.Response) *APIError {
+	apierr := new(APIError)
+	apierr.Response = res
+
+	body := struct {
+		*APIError
+		// ErrorDescription is sent instead of description by the OAuth endpoints.
+		ErrorDescription *string `json:"error_description,omitempty"`
+	}{APIError: apierr}
+	if err := json.NewDecoder(res.Body).Decode(&body); err != nil {
+		apierr.Type = String("Unknown")
+		apierr.Description = String("Oops! Something went wrong when parsing the error response.")
+	}
+	if apierr.Description == nil {
+		apierr.Description = body.ErrorDescription
+	}
+	return apierr
+}
+
 // APIPayload represents the payload of an API call.
 type APIPayload struct {
 	Attachment                 *Attachment                `json:"attachment"`
@@ -289,8 +559,13 @@ type APIPayload struct {
 	Identity                   *UserIdentity              `json:"identity,omitempty"`
 	Identities                 []UserIdentity             `json:"identities,omitempty"`
 	JobStatus                  *JobStatus                 `json:"job_status,omitempty"`
+	JobStatuses                []JobStatus                `json:"job_statuses,omitempty"`
 	Locale                     *Locale                    `json:"locale,omitempty"`
 	Locales                    []Locale                   `json:"locales,omitempty"`
+	OAuthClient                *OAuthClient               `json:"client,omitempty"`
+	OAuthClients               []OAuthClient              `json:"clients,omitempty"`
+	OAuthToken                 *OAuthToken                `json:"token,omitempty"`
+	OAuthTokens                []OAuthToken               `json:"tokens,omitempty"`
 	Organization               *Organization              `json:"organization,omitempty"`
 	OrganizationMembership     *OrganizationMembership    `json:"organization_membership,omitempty"`
 	OrganizationMemberships    []OrganizationMembership   `json:"organization_memberships,omitempty"`
@@ -299,6 +574,8 @@ type APIPayload struct {
 	Ticket                     *Ticket                    `json:"ticket,omitempty"`
 	TicketField                *TicketField               `json:"ticket_field,omitempty"`
 	TicketFields               []TicketField              `json:"ticket_fields,omitempty"`
+	TicketEvents               []TicketEvent              `json:"ticket_events,omitempty"`
+	TicketMetricEvents         []TicketMetricEvent        `json:"ticket_metric_events,omitempty"`
 	Tickets                    []Ticket                   `json:"tickets,omitempty"`
 	Upload                     *Upload                    `json:"upload,omitempty"`
 	User                       *User                      `json:"user,omitempty"`
@@ -308,6 +585,10 @@ type APIPayload struct {
 	NextPage                   *string                    `json:"next_page,omitempty"`
 	PreviousPage               *string                    `json:"previous_page,omitempty"`
 	Count                      *int64                     `json:"count,omitempty"`
+	Meta                       *CursorMeta                `json:"meta,omitempty"`
+	Links                      *CursorLinks               `json:"links,omitempty"`
+	EndOfStream                *bool                      `json:"end_of_stream,omitempty"`
+	EndTime                    *int64                     `json:"end_time,omitempty"`
 }
 
 // TicketSearchResults represents returned results from the unified search api for type:ticket
@@ -320,10 +601,42 @@ type TicketSearchResults struct {
 
 // UserSearchResults represents returned results from the unified search api for type:user
 type UserSearchResults struct {
-	Results      []User `json:"results"`
-	NextPage     *string  `json:"next_page"`
-	PreviousPage *string  `json:"previous_page"`
-	Count        *int64   `json:"count"`
+	Results      []User  `json:"results"`
+	NextPage     *string `json:"next_page"`
+	PreviousPage *string `json:"previous_page"`
+	Count        *int64  `json:"count"`
+}
+
+// OrganizationSearchResults represents returned results from the unified search api for type:organization
+type OrganizationSearchResults struct {
+	Results      []Organization `json:"results"`
+	NextPage     *string        `json:"next_page"`
+	PreviousPage *string        `json:"previous_page"`
+	Count        *int64         `json:"count"`
+}
+
+// GroupSearchResults represents returned results from the unified search api for type:group
+type GroupSearchResults struct {
+	Results      []Group `json:"results"`
+	NextPage     *string `json:"next_page"`
+	PreviousPage *string `json:"previous_page"`
+	Count        *int64  `json:"count"`
+}
+
+// SearchResults represents returned results from the unified search api when
+// the query isn't restricted to a single type
+type SearchResults struct {
+	Results      []SearchResult `json:"results"`
+	NextPage     *string        `json:"next_page"`
+	PreviousPage *string        `json:"previous_page"`
+	Count        *int64         `json:"count"`
+}
+
+// SearchExportResults represents a page of results from the search export api
+type SearchExportResults struct {
+	Results []SearchResult `json:"results"`
+	Meta    *CursorMeta    `json:"meta"`
+	Links   *CursorLinks   `json:"links"`
 }
 
 // APIError represents an error response returnted by the API.
@@ -336,7 +649,15 @@ type APIError struct {
 }
 
 func (e *APIError) Error() string {
-	msg := fmt.Sprintf("%v %v: %d", e.Response.Request.Method, e.Response.Request.URL, e.Response.StatusCode)
+	var msg string
+	switch {
+	case e.Response == nil:
+		msg = "zendesk"
+	case e.Response.Request == nil:
+		msg = fmt.Sprintf("%d", e.Response.StatusCode)
+	default:
+		msg = fmt.Sprintf("%v %v: %d", e.Response.Request.Method, e.Response.Request.URL, e.Response.StatusCode)
+	}
 
 	if e.Type != nil {
 		msg = fmt.Sprintf("%s %v", msg, *e.Type)
@@ -393,14 +714,19 @@ func String(s string) *string {
 
 // ListResponse is a holder for the various returns from the list apis
 type ListResponse struct {
-	Comments     []TicketComment
-	Tickets      []Ticket
-	Users        []User
-	Groups       []Group
-	Audits       []TicketAudit
-	NextPage     *string
-	PreviousPage *string
-	Count        *int64
+	Comments                []TicketComment
+	Tickets                 []Ticket
+	Users                   []User
+	Groups                  []Group
+	Audits                  []TicketAudit
+	Organizations           []Organization
+	OrganizationMemberships []OrganizationMembership
+	NextPage                *string
+	PreviousPage            *string
+	Count                   *int64
+	// Meta and Links are only set by the list methods that use cursor pagination.
+	Meta  *CursorMeta
+	Links *CursorLinks
 }
 
 // ListOptions specifies the optional parameters for the list methods that support pagination.
@@ -417,6 +743,33 @@ type ListOptions struct {
 	SortOrder string `url:"sort_order,omitempty"`
 }
 
+// CursorOptions specifies the parameters for the list methods that support cursor pagination.
+//
+// Zendesk Core API docs: https://developer.zendesk.com/api-reference/introduction/pagination/#using-cursor-pagination
+type CursorOptions struct {
+	// Sets the number of results to include per page.
+	PageSize int `url:"page[size],omitempty"`
+	// Sets the cursor after which to retrieve results, taken from CursorMeta.AfterCursor.
+	After string `url:"page[after],omitempty"`
+	// Sets the cursor before which to retrieve results, taken from CursorMeta.BeforeCursor.
+	Before string `url:"page[before],omitempty"`
+	// Sets the field to sort the retrieved results by. Prefix it with - to sort in descending order.
+	Sort string `url:"sort,omitempty"`
+}
+
+// CursorMeta represents the pagination metadata of a cursor-paginated response.
+type CursorMeta struct {
+	HasMore      bool    `json:"has_more"`
+	AfterCursor  *string `json:"after_cursor,omitempty"`
+	BeforeCursor *string `json:"before_cursor,omitempty"`
+}
+
+// CursorLinks represents the links to the adjacent pages of a cursor-paginated response.
+type CursorLinks struct {
+	Next *string `json:"next,omitempty"`
+	Prev *string `json:"prev,omitempty"`
+}
+
 // Side-Loading
 //
 // Zendesk Core API doscs: https://developer.zendesk.com/rest_api/docs/core/side_loading#side-loading
@@ -441,6 +794,13 @@ func IncludeGroups() SideLoad {
 	}
 }
 
+// IncludeCommentEvents will include the comments in the child events of the ticket events
+func IncludeCommentEvents() SideLoad {
+	return func(c *SideLoadOptions) {
+		c.Include = append(c.Include, "comment_events")
+	}
+}
+
 // IncludeCommentCount will include a top level array of groups
 func IncludeCommentCount() SideLoad {
 	return func(c *SideLoadOptions) {
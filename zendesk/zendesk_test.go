@@ -1,10 +1,12 @@
 package zendesk_test
 
 import (
+	"context"
 	"log"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/MEDIGO/go-zendesk/zendesk"
 	"github.com/stretchr/testify/require"
@@ -35,6 +37,31 @@ func TestClientHeaders(t *testing.T) {
 	require.Equal(t, "bar", <-ch, "expected header")
 }
 
+func TestClientContextCancelsRetryAfter(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(handler))
+	defer server.Close()
+
+	client, err := zendesk.NewURLClient(server.URL, "", "")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err = client.ShowLocaleContext(ctx, 0)
+	require.Equal(t, context.DeadlineExceeded, err)
+	require.True(t, time.Since(start) < 5*time.Second, "expected the retry wait to be interrupted")
+}
+
+func TestMockClientImplementsClient(t *testing.T) {
+	var _ zendesk.Client = new(zendesk.MockClient)
+}
+
 func Example() {
 	client, err := zendesk.NewClient("domain", "username", "password")
 	if err != nil {
@@ -46,3 +73,29 @@ func Example() {
 	}
 	log.Printf("Requester ID is: %d", *ticket.RequesterID)
 }
+
+func TestCaptureResponse(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "abc123")
+		w.Header().Set("X-Rate-Limit", "700")
+		w.Header().Set("X-Rate-Limit-Remaining", "699")
+		w.Header().Set("Ratelimit-Reset", "30")
+		w.Write([]byte(`{"locale":{"id":1}}`))
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(handler))
+	defer server.Close()
+
+	client, err := zendesk.NewURLClient(server.URL, "", "")
+	require.NoError(t, err)
+
+	var res zendesk.Response
+	_, err = client.ShowLocaleContext(zendesk.CaptureResponse(context.Background(), &res), 1)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	require.Equal(t, "abc123", res.RequestID)
+	require.Equal(t, 1, res.Attempts)
+	require.Equal(t, 700, res.RateLimit.Limit)
+	require.Equal(t, 699, res.RateLimit.Remaining)
+	require.WithinDuration(t, time.Now().Add(30*time.Second), res.RateLimit.Reset, 5*time.Second)
+}